@@ -0,0 +1,119 @@
+package starr
+
+import (
+	"errors"
+	"fmt"
+)
+
+/* This file contains helpers for the secrets in download client, indexer and notification fields. */
+
+// Privacy levels the apps put on provider fields. Fields with a level other
+// than PrivacyNormal hold secrets or personal data.
+const (
+	PrivacyNormal   = "normal"
+	PrivacyPassword = "password"
+	PrivacyAPIKey   = "apiKey"
+	PrivacyUserName = "userName"
+)
+
+// MaskedValue is returned by the apps in place of password and API key field values.
+const MaskedValue = "********"
+
+// ErrMaskedSecret is returned when a masked field value would be sent back to an app,
+// and no real value is available. Sending it would replace the real secret with asterisks.
+var ErrMaskedSecret = errors.New("masked secret has no real value")
+
+// SecretResolver returns the real value of a masked field. The provider is the name
+// of the download client, indexer or notification the field belongs to. Return false
+// if the secret is not known.
+type SecretResolver func(provider, field string) (any, bool)
+
+// IsPrivate returns true if the field holds a secret or personal data.
+func (f *FieldOutput) IsPrivate() bool {
+	return f.Privacy != "" && f.Privacy != PrivacyNormal
+}
+
+// IsMasked returns true if the app replaced the field's value with asterisks.
+func (f *FieldOutput) IsMasked() bool {
+	return isMasked(f.Value)
+}
+
+func isMasked(value any) bool {
+	str, ok := value.(string)
+	return ok && str == MaskedValue
+}
+
+// RedactFields returns a copy of fields with the value of every private field replaced
+// by MaskedValue. This includes user names, which the apps do not mask.
+// Use it before exporting or logging provider configuration.
+func RedactFields(fields []*FieldOutput) []*FieldOutput {
+	if fields == nil {
+		return nil
+	}
+
+	output := make([]*FieldOutput, len(fields))
+
+	for idx, field := range fields {
+		redacted := *field
+		if redacted.IsPrivate() && redacted.Value != nil && redacted.Value != "" {
+			redacted.Value = MaskedValue
+		}
+
+		output[idx] = &redacted
+	}
+
+	return output
+}
+
+// ToInput converts an output field into an input field. A masked value is copied as-is.
+func (f *FieldOutput) ToInput() *FieldInput {
+	return &FieldInput{Name: f.Name, Value: f.Value}
+}
+
+// ToFieldInputs converts output fields into input fields. Masked values are copied as-is;
+// use FieldInputs or ResolveSecrets to replace them before sending the fields to an app.
+func ToFieldInputs(fields []*FieldOutput) []*FieldInput {
+	if fields == nil {
+		return nil
+	}
+
+	output := make([]*FieldInput, len(fields))
+	for idx, field := range fields {
+		output[idx] = field.ToInput()
+	}
+
+	return output
+}
+
+// FieldInputs converts output fields into input fields, so an output can be sent back to an app.
+// Masked values are replaced with the value from secrets, and ErrMaskedSecret is returned for a
+// masked value that secrets cannot resolve. Secrets may be nil if no values are masked.
+func FieldInputs(provider string, fields []*FieldOutput, secrets SecretResolver) ([]*FieldInput, error) {
+	output := ToFieldInputs(fields)
+	if err := ResolveSecrets(provider, output, secrets); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// ResolveSecrets replaces masked input field values with the values from secrets.
+// ErrMaskedSecret is returned for the first masked value secrets cannot resolve.
+func ResolveSecrets(provider string, fields []*FieldInput, secrets SecretResolver) error {
+	for _, field := range fields {
+		if !isMasked(field.Value) {
+			continue
+		}
+
+		if secrets != nil {
+			if value, ok := secrets(provider, field.Name); ok {
+				field.Value = value
+				continue
+			}
+		}
+
+		return fmt.Errorf("%w: %s field %s", ErrMaskedSecret, provider, field.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package starr_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+)
+
+func testFields() []*starr.FieldOutput {
+	return []*starr.FieldOutput{
+		{Name: "host", Value: "localhost", Privacy: starr.PrivacyNormal},
+		{Name: "username", Value: "admin", Privacy: starr.PrivacyUserName},
+		{Name: "password", Value: starr.MaskedValue, Privacy: starr.PrivacyPassword},
+		{Name: "apiKey", Privacy: starr.PrivacyAPIKey},
+	}
+}
+
+func TestRedactFields(t *testing.T) {
+	t.Parallel()
+
+	fields := testFields()
+	redacted := starr.RedactFields(fields)
+
+	require.Len(t, redacted, len(fields))
+	assert.Equal(t, "localhost", redacted[0].Value)
+	assert.Equal(t, starr.MaskedValue, redacted[1].Value, "user names must be redacted")
+	assert.Equal(t, starr.MaskedValue, redacted[2].Value)
+	assert.Nil(t, redacted[3].Value, "empty values must stay empty")
+	assert.Equal(t, "admin", fields[1].Value, "the input must not be modified")
+	assert.True(t, redacted[1].IsPrivate())
+	assert.False(t, redacted[0].IsPrivate())
+	assert.True(t, fields[2].IsMasked())
+	assert.False(t, fields[1].IsMasked())
+}
+
+func TestFieldInputs(t *testing.T) {
+	t.Parallel()
+
+	_, err := starr.FieldInputs("qbit", testFields(), nil)
+	require.ErrorIs(t, err, starr.ErrMaskedSecret, "masked values must never be sent back")
+
+	secrets := func(provider, field string) (any, bool) {
+		if provider == "qbit" && field == "password" {
+			return "hunter2", true
+		}
+
+		return nil, false
+	}
+
+	inputs, err := starr.FieldInputs("qbit", testFields(), secrets)
+	require.NoError(t, err)
+	assert.Equal(t, []*starr.FieldInput{
+		{Name: "host", Value: "localhost"},
+		{Name: "username", Value: "admin"},
+		{Name: "password", Value: "hunter2"},
+		{Name: "apiKey"},
+	}, inputs)
+
+	_, err = starr.FieldInputs("sabnzbd", testFields(), secrets)
+	require.ErrorIs(t, err, starr.ErrMaskedSecret)
+}
@@ -0,0 +1,151 @@
+package lidarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const (
+	bpConfigHost = APIver + "/config/host"
+	bpConfigUI   = APIver + "/config/ui"
+)
+
+// HostConfig is the /api/v1/config/host resource.
+type HostConfig = starrshared.HostConfig
+
+// UIConfig is the /api/v1/config/ui resource.
+type UIConfig struct {
+	ID                       int    `json:"id,omitempty"`
+	FirstDayOfWeek           int    `json:"firstDayOfWeek"`
+	CalendarWeekColumnHeader string `json:"calendarWeekColumnHeader,omitempty"`
+	ShortDateFormat          string `json:"shortDateFormat,omitempty"`
+	LongDateFormat           string `json:"longDateFormat,omitempty"`
+	TimeFormat               string `json:"timeFormat,omitempty"`
+	ShowRelativeDates        bool   `json:"showRelativeDates"`
+	EnableColorImpairedMode  bool   `json:"enableColorImpairedMode"`
+	UILanguage               int    `json:"uiLanguage"`
+	ExpandAlbumByDefault     bool   `json:"expandAlbumByDefault"`
+	ExpandSingleByDefault    bool   `json:"expandSingleByDefault"`
+	ExpandEPByDefault        bool   `json:"expandEPByDefault"`
+	ExpandBroadcastByDefault bool   `json:"expandBroadcastByDefault"`
+	ExpandOtherByDefault     bool   `json:"expandOtherByDefault"`
+	Theme                    string `json:"theme,omitempty"`
+}
+
+// GetHostConfig returns the host configuration.
+func (l *Lidarr) GetHostConfig() (*HostConfig, error) {
+	return l.GetHostConfigContext(context.Background())
+}
+
+// GetHostConfigContext returns the host configuration.
+func (l *Lidarr) GetHostConfigContext(ctx context.Context) (*HostConfig, error) {
+	var output HostConfig
+
+	req := starr.Request{URI: bpConfigHost}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetHostConfigByID returns the host configuration for the given id.
+func (l *Lidarr) GetHostConfigByID(id int) (*HostConfig, error) {
+	return l.GetHostConfigByIDContext(context.Background(), id)
+}
+
+// GetHostConfigByIDContext returns the host configuration for the given id.
+func (l *Lidarr) GetHostConfigByIDContext(ctx context.Context, id int) (*HostConfig, error) {
+	var output HostConfig
+
+	req := starr.Request{URI: path.Join(bpConfigHost, starr.Str(id))}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateHostConfig updates the host configuration.
+func (l *Lidarr) UpdateHostConfig(input *HostConfig) (*HostConfig, error) {
+	return l.UpdateHostConfigContext(context.Background(), input)
+}
+
+// UpdateHostConfigContext updates the host configuration.
+func (l *Lidarr) UpdateHostConfigContext(ctx context.Context, input *HostConfig) (*HostConfig, error) {
+	var output HostConfig
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpConfigHost, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpConfigHost, starr.Str(input.ID)), Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetUIConfig returns the UI configuration.
+func (l *Lidarr) GetUIConfig() (*UIConfig, error) {
+	return l.GetUIConfigContext(context.Background())
+}
+
+// GetUIConfigContext returns the UI configuration.
+func (l *Lidarr) GetUIConfigContext(ctx context.Context) (*UIConfig, error) {
+	var output UIConfig
+
+	req := starr.Request{URI: bpConfigUI}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetUIConfigByID returns the UI configuration for the given id.
+func (l *Lidarr) GetUIConfigByID(id int) (*UIConfig, error) {
+	return l.GetUIConfigByIDContext(context.Background(), id)
+}
+
+// GetUIConfigByIDContext returns the UI configuration for the given id.
+func (l *Lidarr) GetUIConfigByIDContext(ctx context.Context, id int) (*UIConfig, error) {
+	var output UIConfig
+
+	req := starr.Request{URI: path.Join(bpConfigUI, starr.Str(id))}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateUIConfig updates the UI configuration.
+func (l *Lidarr) UpdateUIConfig(input *UIConfig) (*UIConfig, error) {
+	return l.UpdateUIConfigContext(context.Background(), input)
+}
+
+// UpdateUIConfigContext updates the UI configuration.
+func (l *Lidarr) UpdateUIConfigContext(ctx context.Context, input *UIConfig) (*UIConfig, error) {
+	var output UIConfig
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpConfigUI, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpConfigUI, starr.Str(input.ID)), Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
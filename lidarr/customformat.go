@@ -49,6 +49,36 @@ type CustomFormatOutputSpec struct {
 	Fields             []*starr.FieldOutput `json:"fields"`
 }
 
+// ToInput converts the output of a custom format into an input, so it can be changed and sent back.
+func (f *CustomFormatOutput) ToInput() *CustomFormatInput {
+	if f == nil {
+		return nil
+	}
+
+	specs := make([]*CustomFormatInputSpec, len(f.Specifications))
+	for idx, spec := range f.Specifications {
+		specs[idx] = spec.ToInput()
+	}
+
+	return &CustomFormatInput{
+		ID:                    f.ID,
+		Name:                  f.Name,
+		IncludeCFWhenRenaming: f.IncludeCFWhenRenaming,
+		Specifications:        specs,
+	}
+}
+
+// ToInput converts a custom format specification output into an input.
+func (s *CustomFormatOutputSpec) ToInput() *CustomFormatInputSpec {
+	return &CustomFormatInputSpec{
+		Name:           s.Name,
+		Implementation: s.Implementation,
+		Negate:         s.Negate,
+		Required:       s.Required,
+		Fields:         starr.ToFieldInputs(s.Fields),
+	}
+}
+
 // GetCustomFormats returns all configured Custom Formats.
 func (l *Lidarr) GetCustomFormats() ([]*CustomFormatOutput, error) {
 	return l.GetCustomFormatsContext(context.Background())
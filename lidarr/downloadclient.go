@@ -45,6 +45,28 @@ type DownloadClientOutput struct {
 	Fields                   []*starr.FieldOutput `json:"fields"`
 }
 
+// ToInput converts the output of a download client into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (d *DownloadClientOutput) ToInput() *DownloadClientInput {
+	if d == nil {
+		return nil
+	}
+
+	return &DownloadClientInput{
+		Enable:                   d.Enable,
+		RemoveCompletedDownloads: d.RemoveCompletedDownloads,
+		RemoveFailedDownloads:    d.RemoveFailedDownloads,
+		Priority:                 d.Priority,
+		ID:                       d.ID,
+		ConfigContract:           d.ConfigContract,
+		Implementation:           d.Implementation,
+		Name:                     d.Name,
+		Protocol:                 d.Protocol,
+		Tags:                     d.Tags,
+		Fields:                   starr.ToFieldInputs(d.Fields),
+	}
+}
+
 // GetDownloadClients returns all configured download clients.
 func (l *Lidarr) GetDownloadClients() ([]*DownloadClientOutput, error) {
 	return l.GetDownloadClientsContext(context.Background())
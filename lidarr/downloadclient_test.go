@@ -502,3 +502,61 @@ func TestDeleteDownloadClient(t *testing.T) {
 		})
 	}
 }
+
+const customFormatResponseBody = `{
+    "id": 3,
+    "name": "FLAC",
+    "includeCustomFormatWhenRenaming": true,
+    "specifications": [{
+        "id": 1,
+        "name": "FLAC",
+        "implementation": "ReleaseTitleSpecification",
+        "implementationName": "Release Title",
+        "infoLink": "https://wiki.servarr.com/lidarr/settings#custom-formats-2",
+        "negate": true,
+        "required": true,
+        "fields": [{"order": 0, "name": "value", "label": "Regular Expression", "value": "flac", "type": "textbox"}]
+    }]
+}`
+
+const importListResponseBody = `{
+    "enableAutomaticAdd": true,
+    "shouldMonitorExisting": true,
+    "shouldSearch": true,
+    "listOrder": 1,
+    "id": 3,
+    "qualityProfileId": 4,
+    "metadataProfileId": 5,
+    "shouldMonitor": "entireArtist",
+    "rootFolderPath": "/music",
+    "monitorNewItems": "all",
+    "listType": "program",
+    "name": "Playlists",
+    "implementationName": "Spotify Playlists",
+    "implementation": "SpotifyPlaylist",
+    "configContract": "SpotifyPlaylistSettings",
+    "infoLink": "https://wiki.servarr.com/lidarr/supported#spotifyplaylist",
+    "tags": [1],
+    "fields": [{"order": 0, "name": "playlistIds", "label": "Playlists", "value": ["a1"], "type": "playlist"}],
+    "message": {"message": "Authenticate with Spotify", "type": "info"}
+}`
+
+func TestOutputToInput(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(*testing.T){
+		"CustomFormat":   starrtest.ToInputCase((*lidarr.CustomFormatOutput).ToInput, customFormatResponseBody),
+		"DownloadClient": starrtest.ToInputCase((*lidarr.DownloadClientOutput).ToInput, downloadClientResponseBody),
+		"ImportList":     starrtest.ToInputCase((*lidarr.ImportListOutput).ToInput, importListResponseBody),
+		"Indexer":        starrtest.ToInputCase((*lidarr.IndexerOutput).ToInput, indexerResponseBody),
+		"Metadata":       starrtest.ToInputCase((*lidarr.MetadataOutput).ToInput, metadataBody),
+		"Notification":   starrtest.ToInputCase((*lidarr.NotificationOutput).ToInput, notificationResponseBody),
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			test(t)
+		})
+	}
+}
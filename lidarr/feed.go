@@ -1,6 +1,7 @@
 package lidarr
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -9,10 +10,11 @@ import (
 	"strings"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 // This is not an /api path.
-const bpFeed = "feed/" + APIver + "/calendar/lidarr.ics"
+const bpFeed = "/feed/" + APIver + "/calendar/lidarr.ics"
 
 // Feed is the /feed/v1/calendar endpoint.
 type Feed struct {
@@ -25,6 +27,9 @@ type Feed struct {
 	Unmonitored bool `json:"unmonitored"`
 }
 
+// FeedEvent is one release in the calendar ICS feed.
+type FeedEvent = starrshared.FeedEvent
+
 // GetFeed returns the Calendar ICS feed file.
 func (r *Lidarr) GetFeed(filter Feed) ([]byte, error) {
 	return r.GetFeedContext(context.Background(), filter)
@@ -57,3 +62,23 @@ func (r *Lidarr) GetFeedContext(ctx context.Context, filter Feed) ([]byte, error
 
 	return body, nil
 }
+
+// GetFeedEvents returns the Calendar ICS feed, parsed into events.
+func (r *Lidarr) GetFeedEvents(filter Feed) ([]*FeedEvent, error) {
+	return r.GetFeedEventsContext(context.Background(), filter)
+}
+
+// GetFeedEventsContext returns the Calendar ICS feed, parsed into events.
+func (r *Lidarr) GetFeedEventsContext(ctx context.Context, filter Feed) ([]*FeedEvent, error) {
+	body, err := r.GetFeedContext(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := starrshared.ParseFeed(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", bpFeed, err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,64 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/starrtest"
+)
+
+const feedBody = "BEGIN:VCALENDAR\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:Lidarr_album_12\r\n" +
+	"DTSTART:20260412T010000Z\r\n" +
+	"DTEND:20260412T020000Z\r\n" +
+	"SUMMARY:The Artist - The Album\r\n" +
+	"CATEGORIES:Album\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestGetFeedEvents(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   "/feed/v1/calendar/lidarr.ics?futureDays=14&pastDays=1&tags=1%2C2&unmonitored=true",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   feedBody,
+			WithResponse: []*lidarr.FeedEvent{{
+				UID:        "Lidarr_album_12",
+				Start:      time.Date(2026, 4, 12, 1, 0, 0, 0, time.UTC),
+				End:        time.Date(2026, 4, 12, 2, 0, 0, 0, time.UTC),
+				Summary:    "The Artist - The Album",
+				Categories: []string{"Album"},
+			}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   "/feed/v1/calendar/lidarr.ics?futureDays=14&pastDays=1&tags=1%2C2&unmonitored=true",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*lidarr.FeedEvent)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetFeedEvents(lidarr.Feed{PastDays: 1, FutureDays: 14, Tags: []int{1, 2}, Unmonitored: true})
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
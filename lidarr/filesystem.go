@@ -0,0 +1,82 @@
+package lidarr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpFilesystem = APIver + "/filesystem"
+
+// FilesystemQuery is the query for /api/v1/filesystem.
+type FilesystemQuery = starrshared.FilesystemQuery
+
+// BrowseFilesystem lists files and folders for a path.
+func (l *Lidarr) BrowseFilesystem(query *FilesystemQuery) ([]*starr.Path, error) {
+	return l.BrowseFilesystemContext(context.Background(), query)
+}
+
+// BrowseFilesystemContext lists files and folders for a path.
+func (l *Lidarr) BrowseFilesystemContext(ctx context.Context, query *FilesystemQuery) ([]*starr.Path, error) {
+	var output []*starr.Path
+
+	req := starr.Request{URI: bpFilesystem, Query: query.Values()}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// BrowseFilesystemMediaFiles lists media files under a path.
+func (l *Lidarr) BrowseFilesystemMediaFiles(pathName string) ([]*starr.Path, error) {
+	return l.BrowseFilesystemMediaFilesContext(context.Background(), pathName)
+}
+
+// BrowseFilesystemMediaFilesContext lists media files under a path.
+func (l *Lidarr) BrowseFilesystemMediaFilesContext(ctx context.Context, pathName string) ([]*starr.Path, error) {
+	var output []*starr.Path
+
+	params := make(url.Values)
+	if pathName != "" {
+		params.Set("path", pathName)
+	}
+
+	req := starr.Request{URI: path.Join(bpFilesystem, "mediafiles"), Query: params}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetFilesystemType returns the raw response body from /api/v1/filesystem/type.
+func (l *Lidarr) GetFilesystemType(pathName string) ([]byte, error) {
+	return l.GetFilesystemTypeContext(context.Background(), pathName)
+}
+
+// GetFilesystemTypeContext returns the raw response body from /api/v1/filesystem/type.
+func (l *Lidarr) GetFilesystemTypeContext(ctx context.Context, pathName string) ([]byte, error) {
+	params := make(url.Values)
+	params.Set("path", pathName)
+
+	req := starr.Request{URI: starr.SetAPIPath(path.Join(bpFilesystem, "type")), Query: params}
+
+	resp, err := l.Get(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading HTTP response body: %w", err)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,103 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestBrowseFilesystem(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, lidarr.APIver, "filesystem") +
+				"?allowFoldersWithoutTrailingSlashes=false&includeFiles=true&path=%2Fmusic%2F",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"name":"Muse","path":"/music/Muse/"}]`,
+			WithRequest:    &lidarr.FilesystemQuery{Path: "/music/", IncludeFiles: true},
+			WithResponse:   []*starr.Path{{Name: "Muse", Path: "/music/Muse/"}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "filesystem"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    (*lidarr.FilesystemQuery)(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*starr.Path(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.BrowseFilesystem(test.WithRequest.(*lidarr.FilesystemQuery))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestBrowseFilesystemMediaFiles(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "filesystem", "mediafiles") + "?path=%2Fdownloads",
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `[{"path":"/downloads/Muse - Uprising.flac","relativePath":"Muse - Uprising.flac","name":"Muse - Uprising.flac"}]`,
+		WithResponse:   []*starr.Path{{Name: "Muse - Uprising.flac", Path: "/downloads/Muse - Uprising.flac"}},
+	}
+
+	client := lidarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	output, err := client.BrowseFilesystemMediaFiles("/downloads")
+	require.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+}
+
+func TestGetFilesystemType(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "filesystem", "type") + "?path=%2Fmusic",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"type":"folder"}`,
+			WithResponse:   []byte(`{"type":"folder"}`),
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "filesystem", "type") + "?path=%2Fmusic",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []byte(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetFilesystemType("/music")
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.Equal(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
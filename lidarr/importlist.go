@@ -58,6 +58,33 @@ type ImportListOutput struct {
 	} `json:"message"`
 }
 
+// ToInput converts the output of an import list into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (l *ImportListOutput) ToInput() *ImportListInput {
+	if l == nil {
+		return nil
+	}
+
+	return &ImportListInput{
+		EnableAutomaticAdd:    l.EnableAutomaticAdd,
+		ShouldMonitorExisting: l.ShouldMonitorExisting,
+		ShouldSearch:          l.ShouldSearch,
+		ListOrder:             l.ListOrder,
+		ID:                    l.ID,
+		QualityProfileID:      l.QualityProfileID,
+		MetadataProfileID:     l.MetadataProfileID,
+		ConfigContract:        l.ConfigContract,
+		Implementation:        l.Implementation,
+		ListType:              l.ListType,
+		MonitorNewItems:       l.MonitorNewItems,
+		Name:                  l.Name,
+		RootFolderPath:        l.RootFolderPath,
+		ShouldMonitor:         l.ShouldMonitor,
+		Tags:                  l.Tags,
+		Fields:                starr.ToFieldInputs(l.Fields),
+	}
+}
+
 // GetImportLists returns all configured import lists.
 func (l *Lidarr) GetImportLists() ([]*ImportListOutput, error) {
 	return l.GetImportListsContext(context.Background())
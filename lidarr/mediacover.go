@@ -0,0 +1,67 @@
+package lidarr
+
+import (
+	"context"
+	"net/url"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpMediaCover = APIver + "/mediacover"
+
+// MediaCover is an open media cover image download.
+type MediaCover = starrshared.MediaCover
+
+// GetArtistMediaCover downloads an artist media cover image (jpg, png, or gif).
+// The filename is from the image URL, like poster.jpg or fanart-500.jpg.
+func (l *Lidarr) GetArtistMediaCover(artistID int64, filename string) ([]byte, error) {
+	return l.GetArtistMediaCoverContext(context.Background(), artistID, filename)
+}
+
+// GetArtistMediaCoverContext downloads an artist media cover image (jpg, png, or gif).
+// The filename is from the image URL, like poster.jpg or fanart-500.jpg.
+func (l *Lidarr) GetArtistMediaCoverContext(ctx context.Context, artistID int64, filename string) ([]byte, error) {
+	uri := path.Join(bpMediaCover, "artist", starr.Str(artistID), url.PathEscape(filename))
+	return starrshared.ReadMediaCover(ctx, l.APIer, uri)
+}
+
+// OpenArtistMediaCover opens an artist media cover image; pass a previous ETag to skip an unchanged image.
+func (l *Lidarr) OpenArtistMediaCover(artistID int64, filename, etag string) (*MediaCover, error) {
+	return l.OpenArtistMediaCoverContext(context.Background(), artistID, filename, etag)
+}
+
+// OpenArtistMediaCoverContext opens an artist media cover image; pass a previous ETag to skip an unchanged image.
+func (l *Lidarr) OpenArtistMediaCoverContext(
+	ctx context.Context, artistID int64, filename, etag string,
+) (*MediaCover, error) {
+	uri := path.Join(bpMediaCover, "artist", starr.Str(artistID), url.PathEscape(filename))
+	return starrshared.OpenMediaCover(ctx, l.APIer, uri, etag)
+}
+
+// GetAlbumMediaCover downloads an album media cover image (jpg, png, or gif).
+// The filename is from the image URL, like poster.jpg or fanart-500.jpg.
+func (l *Lidarr) GetAlbumMediaCover(albumID int64, filename string) ([]byte, error) {
+	return l.GetAlbumMediaCoverContext(context.Background(), albumID, filename)
+}
+
+// GetAlbumMediaCoverContext downloads an album media cover image (jpg, png, or gif).
+// The filename is from the image URL, like poster.jpg or fanart-500.jpg.
+func (l *Lidarr) GetAlbumMediaCoverContext(ctx context.Context, albumID int64, filename string) ([]byte, error) {
+	uri := path.Join(bpMediaCover, "album", starr.Str(albumID), url.PathEscape(filename))
+	return starrshared.ReadMediaCover(ctx, l.APIer, uri)
+}
+
+// OpenAlbumMediaCover opens an album media cover image; pass a previous ETag to skip an unchanged image.
+func (l *Lidarr) OpenAlbumMediaCover(albumID int64, filename, etag string) (*MediaCover, error) {
+	return l.OpenAlbumMediaCoverContext(context.Background(), albumID, filename, etag)
+}
+
+// OpenAlbumMediaCoverContext opens an album media cover image; pass a previous ETag to skip an unchanged image.
+func (l *Lidarr) OpenAlbumMediaCoverContext(
+	ctx context.Context, albumID int64, filename, etag string,
+) (*MediaCover, error) {
+	uri := path.Join(bpMediaCover, "album", starr.Str(albumID), url.PathEscape(filename))
+	return starrshared.OpenMediaCover(ctx, l.APIer, uri, etag)
+}
@@ -0,0 +1,51 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+)
+
+func TestMediaCover(t *testing.T) {
+	t.Parallel()
+
+	const etag = `"5f2e"`
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("If-None-Match") == etag {
+			writer.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		writer.Header().Set("ETag", etag)
+		_, _ = writer.Write([]byte(req.URL.EscapedPath()))
+	}))
+	t.Cleanup(server.Close)
+
+	client := lidarr.New(starr.New("mockAPIkey", server.URL, 0))
+
+	body, err := client.GetArtistMediaCover(3, "poster 500.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v1/mediacover/artist/3/poster%20500.jpg", string(body))
+
+	body, err = client.GetAlbumMediaCover(4, "cover.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v1/mediacover/album/4/cover.jpg", string(body))
+
+	cover, err := client.OpenAlbumMediaCover(4, "cover.jpg", etag)
+	require.NoError(t, err, "a 304 must not be an error")
+	assert.True(t, cover.NotModified)
+	assert.Equal(t, etag, cover.ETag)
+	require.NoError(t, cover.Close())
+
+	cover, err = client.OpenArtistMediaCover(3, "fanart.jpg", `"old"`)
+	require.NoError(t, err)
+	assert.False(t, cover.NotModified)
+	assert.Equal(t, etag, cover.ETag)
+	require.NoError(t, cover.Close())
+}
@@ -0,0 +1,126 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/starrtest"
+)
+
+const metadataBody = `{
+	"enable": true,
+	"name": "Kodi (XBMC) / Emby",
+	"fields": [{"order": 0, "name": "artistMetadata", "label": "Artist Metadata", "value": true, "type": "checkbox"}],
+	"implementationName": "Kodi (XBMC) / Emby",
+	"implementation": "XbmcMetadata",
+	"configContract": "XbmcMetadataSettings",
+	"infoLink": "https://wiki.servarr.com/lidarr/supported#xbmcmetadata",
+	"tags": [1],
+	"id": 1
+}`
+
+func TestGetMetadataByID(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "metadata", "1"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    int64(1),
+			ResponseBody:   metadataBody,
+			WithResponse: &lidarr.MetadataOutput{
+				ID:     1,
+				Enable: true,
+				Name:   "Kodi (XBMC) / Emby",
+				Fields: []*starr.FieldOutput{
+					{Order: 0, Name: "artistMetadata", Label: "Artist Metadata", Value: true, Type: "checkbox"},
+				},
+				ImplementationName: "Kodi (XBMC) / Emby",
+				Implementation:     "XbmcMetadata",
+				ConfigContract:     "XbmcMetadataSettings",
+				InfoLink:           "https://wiki.servarr.com/lidarr/supported#xbmcmetadata",
+				Tags:               []int{1},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "metadata", "1"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    int64(1),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*lidarr.MetadataOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMetadataByID(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestUpdateMetadata(t *testing.T) {
+	t.Parallel()
+
+	input := &lidarr.MetadataInput{
+		ID:             1,
+		Name:           "Kodi (XBMC) / Emby",
+		Fields:         []*starr.FieldInput{{Name: "artistMetadata", Value: false}},
+		Implementation: "XbmcMetadata",
+		ConfigContract: "XbmcMetadataSettings",
+		Enable:         true,
+	}
+	request := `{"id":1,"name":"Kodi (XBMC) / Emby","fields":[{"name":"artistMetadata","value":false}],` +
+		`"implementation":"XbmcMetadata","configContract":"XbmcMetadataSettings","enable":true}` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "metadata", "1?forceSave=true"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  200,
+			WithRequest:     input,
+			ExpectedRequest: request,
+			ResponseBody:    `{"id": 1, "name": "Kodi (XBMC) / Emby", "enable": true}`,
+			WithResponse:    &lidarr.MetadataOutput{ID: 1, Name: "Kodi (XBMC) / Emby", Enable: true},
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "metadata", "1?forceSave=true"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  404,
+			WithRequest:     input,
+			ExpectedRequest: request,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    (*lidarr.MetadataOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.UpdateMetadata(test.WithRequest.(*lidarr.MetadataInput), true)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
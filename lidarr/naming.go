@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"path"
 
 	"golift.io/starr"
 )
@@ -77,3 +79,48 @@ func (l *Lidarr) UpdateNamingContext(ctx context.Context, naming *Naming) (*Nami
 
 	return &output, nil
 }
+
+// NamingExamples are the file and folder names rendered from a naming config by PreviewNaming.
+// An example is empty when its format is empty or invalid.
+type NamingExamples struct {
+	SingleTrackExample    string `json:"singleTrackExample"`
+	MultiDiscTrackExample string `json:"multiDiscTrackExample"`
+	ArtistFolderExample   string `json:"artistFolderExample"`
+}
+
+// PreviewNaming renders example file and folder names from a naming config, without saving it.
+func (l *Lidarr) PreviewNaming(naming *Naming) (*NamingExamples, error) {
+	return l.PreviewNamingContext(context.Background(), naming)
+}
+
+// PreviewNamingContext renders example file and folder names from a naming config, without saving it.
+func (l *Lidarr) PreviewNamingContext(ctx context.Context, naming *Naming) (*NamingExamples, error) {
+	var output NamingExamples
+
+	req := starr.Request{URI: path.Join(bpNaming, "examples"), Query: naming.values()}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// values turns a naming config into the query parameters for the examples endpoint.
+func (n *Naming) values() url.Values {
+	if n == nil {
+		n = &Naming{}
+	}
+
+	return url.Values{
+		"renameTracks":             {starr.Str(n.RenameTracks)},
+		"replaceIllegalCharacters": {starr.Str(n.ReplaceIllegalCharacters)},
+		"colonReplacementFormat":   {starr.Str(int(n.ColonReplacementFormat))},
+		"standardTrackFormat":      {n.StandardTrackFormat},
+		"multiDiscTrackFormat":     {n.MultiDiscTrackFormat},
+		"artistFolderFormat":       {n.ArtistFolderFormat},
+		"includeArtistName":        {starr.Str(n.IncludeArtistName)},
+		"includeAlbumTitle":        {starr.Str(n.IncludeAlbumTitle)},
+		"includeQuality":           {starr.Str(n.IncludeQuality)},
+		"replaceSpaces":            {starr.Str(n.ReplaceSpaces)},
+	}
+}
@@ -0,0 +1,45 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestPreviewNaming(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath: path.Join("/", starr.API, lidarr.APIver, "config", "naming", "examples") +
+			"?artistFolderFormat=%7BArtist+Name%7D&colonReplacementFormat=1&includeAlbumTitle=false" +
+			"&includeArtistName=false&includeQuality=true&multiDiscTrackFormat=&renameTracks=true" +
+			"&replaceIllegalCharacters=true&replaceSpaces=false" +
+			"&standardTrackFormat=%7BAlbum+Title%7D%2F%7Btrack%3A00%7D+-+%7BTrack+Title%7D",
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `{"singleTrackExample":"The Album Title/01 - Track Title","artistFolderExample":"The Artist Name"}`,
+		WithRequest: &lidarr.Naming{
+			RenameTracks:             true,
+			ReplaceIllegalCharacters: true,
+			IncludeQuality:           true,
+			ColonReplacementFormat:   lidarr.ColonReplaceWithDash,
+			StandardTrackFormat:      "{Album Title}/{track:00} - {Track Title}",
+			ArtistFolderFormat:       "{Artist Name}",
+		},
+		WithResponse: &lidarr.NamingExamples{
+			SingleTrackExample:  "The Album Title/01 - Track Title",
+			ArtistFolderExample: "The Artist Name",
+		},
+	}
+
+	client := lidarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	output, err := client.PreviewNaming(test.WithRequest.(*lidarr.Naming))
+	require.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+}
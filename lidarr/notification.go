@@ -64,6 +64,33 @@ type NotificationOutput struct {
 	Fields                      []*starr.FieldOutput `json:"fields"`
 }
 
+// ToInput converts the output of a notification into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (n *NotificationOutput) ToInput() *NotificationInput {
+	if n == nil {
+		return nil
+	}
+
+	return &NotificationInput{
+		OnGrab:                n.OnGrab,
+		OnReleaseImport:       n.OnReleaseImport,
+		OnUpgrade:             n.OnUpgrade,
+		OnRename:              n.OnRename,
+		OnTrackRetag:          n.OnTrackRetag,
+		OnHealthIssue:         n.OnHealthIssue,
+		OnDownloadFailure:     n.OnDownloadFailure,
+		OnImportFailure:       n.OnImportFailure,
+		OnApplicationUpdate:   n.OnApplicationUpdate,
+		IncludeHealthWarnings: n.IncludeHealthWarnings,
+		ID:                    n.ID,
+		Name:                  n.Name,
+		Implementation:        n.Implementation,
+		ConfigContract:        n.ConfigContract,
+		Tags:                  n.Tags,
+		Fields:                starr.ToFieldInputs(n.Fields),
+	}
+}
+
 // GetNotifications returns all configured notifications.
 func (l *Lidarr) GetNotifications() ([]*NotificationOutput, error) {
 	return l.GetNotificationsContext(context.Background())
@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"path"
+	"time"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpQualityDefinition = APIver + "/qualitydefinition"
@@ -106,3 +108,25 @@ func (l *Lidarr) UpdateQualityDefinitionsContext(
 
 	return output, nil
 }
+
+// QualitySize is the range of release sizes, in bytes, that a quality definition accepts.
+type QualitySize = starrshared.QualitySize
+
+// Size returns the release sizes this quality definition accepts for a runtime.
+// Sizes are kilobits per second. Convert an album or track duration in milliseconds
+// with time.Duration(duration) * time.Millisecond.
+func (q *QualityDefinition) Size(runtime time.Duration) *QualitySize {
+	bytes := starrshared.Kilobit * runtime.Seconds()
+
+	return &QualitySize{
+		Min:       int64(q.MinSize * bytes),
+		Preferred: int64(q.PreferredSize * bytes),
+		Max:       int64(q.MaxSize * bytes),
+	}
+}
+
+// Accepts returns true if a release of this many bytes and this runtime is inside the quality definition's sizes.
+// Like the app, a zero runtime accepts every size.
+func (q *QualityDefinition) Accepts(size int64, runtime time.Duration) bool {
+	return q.Size(runtime).Accepts(size)
+}
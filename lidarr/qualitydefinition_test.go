@@ -0,0 +1,26 @@
+package lidarr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr/lidarr"
+)
+
+func TestQualityDefinitionSize(t *testing.T) {
+	t.Parallel()
+
+	// Sizes are kilobits per second: 320 kbps for 4 minutes is 9600 KiB.
+	definition := &lidarr.QualityDefinition{MinSize: 100, PreferredSize: 320, MaxSize: 1000}
+	runtime := time.Duration(240000) * time.Millisecond
+	size := definition.Size(runtime)
+	assert.Equal(t, &lidarr.QualitySize{Min: 3000 << 10, Preferred: 9600 << 10, Max: 30000 << 10}, size)
+	assert.True(t, definition.Accepts(5<<20, runtime))
+	assert.False(t, definition.Accepts(1<<20, runtime), "too small")
+	assert.False(t, definition.Accepts(50<<20, runtime), "too big")
+	assert.True(t, definition.Accepts(1<<40, 0), "a zero runtime accepts every size")
+
+	definition.MaxSize = 0
+	assert.True(t, definition.Accepts(1<<40, runtime), "a zero max size is unlimited")
+}
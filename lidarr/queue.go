@@ -5,10 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"path"
 	"time"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpQueue = APIver + "/queue"
@@ -47,6 +49,9 @@ type QueueRecord struct {
 	ErrorMessage            string                 `json:"errorMessage"`
 }
 
+// QueueStatus is the aggregate queue status from /api/v1/queue/status.
+type QueueStatus = starrshared.QueueStatus
+
 // GetQueue returns a single page from the Lidarr Queue (processing, but not yet imported).
 // If you need control over the page, use lidarr.GetQueuePage().
 // This function simply returns the number of queue records desired,
@@ -151,3 +156,90 @@ func (l *Lidarr) QueueGrabContext(ctx context.Context, ids ...int64) error {
 
 	return nil
 }
+
+// GetQueueRecords returns the queue records without paging, optionally filtered.
+// Pass a zero artistID and no albumIDs to return the whole queue.
+func (l *Lidarr) GetQueueRecords(artistID int64, albumIDs ...int64) ([]*QueueRecord, error) {
+	return l.GetQueueRecordsContext(context.Background(), artistID, albumIDs...)
+}
+
+// GetQueueRecordsContext returns the queue records without paging, optionally filtered.
+// Pass a zero artistID and no albumIDs to return the whole queue.
+func (l *Lidarr) GetQueueRecordsContext(
+	ctx context.Context, artistID int64, albumIDs ...int64,
+) ([]*QueueRecord, error) {
+	var output []*QueueRecord
+
+	req := starr.Request{URI: path.Join(bpQueue, "details"), Query: make(url.Values)}
+	if artistID != 0 {
+		req.Query.Set("artistId", starr.Str(artistID))
+	}
+
+	for _, id := range albumIDs {
+		req.Query.Add("albumIds", starr.Str(id))
+	}
+
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetQueueStatus returns aggregate queue status.
+func (l *Lidarr) GetQueueStatus() (*QueueStatus, error) {
+	return l.GetQueueStatusContext(context.Background())
+}
+
+// GetQueueStatusContext returns aggregate queue status.
+func (l *Lidarr) GetQueueStatusContext(ctx context.Context) (*QueueStatus, error) {
+	var output QueueStatus
+
+	req := starr.Request{URI: path.Join(bpQueue, "status")}
+	if err := l.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteQueueBulk removes multiple items from the Activity Queue in one request.
+// The options apply to every item, the same as DeleteQueue.
+func (l *Lidarr) DeleteQueueBulk(ids []int64, opts *starr.QueueDeleteOpts) error {
+	return l.DeleteQueueBulkContext(context.Background(), ids, opts)
+}
+
+// DeleteQueueBulkContext removes multiple items from the Activity Queue in one request.
+// The options apply to every item, the same as DeleteQueue.
+func (l *Lidarr) DeleteQueueBulkContext(ctx context.Context, ids []int64, opts *starr.QueueDeleteOpts) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: ids}); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpQueue, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpQueue, "bulk"), Body: &body, Query: opts.Values()}
+	if err := l.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// QueueGrabOne tells Lidarr to grab a single delayed queue item.
+func (l *Lidarr) QueueGrabOne(queueID int64) error {
+	return l.QueueGrabOneContext(context.Background(), queueID)
+}
+
+// QueueGrabOneContext tells Lidarr to grab a single delayed queue item.
+func (l *Lidarr) QueueGrabOneContext(ctx context.Context, queueID int64) error {
+	var output any // any ok
+
+	req := starr.Request{URI: path.Join(bpQueue, "grab", starr.Str(queueID))}
+	if err := l.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
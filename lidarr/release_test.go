@@ -0,0 +1,128 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/starrtest"
+)
+
+const releaseResponseBody = `{
+    "guid": "abc123",
+    "quality": {"quality": {"id": 6, "name": "FLAC"}, "revision": {"version": 1, "real": 0}},
+    "size": 512000000,
+    "indexerId": 2,
+    "indexer": "Redacted",
+    "title": "Artist - Album (2020) [FLAC]",
+    "artistName": "Artist",
+    "albumTitle": "Album",
+    "approved": true,
+    "rejections": [],
+    "protocol": "torrent",
+    "artistId": 4,
+    "albumId": 5
+}`
+
+func TestSearchRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "release") + "?albumId=5",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			WithRequest:    &lidarr.SearchRelease{AlbumID: 5},
+			ResponseBody:   "[" + releaseResponseBody + "]",
+			WithResponse: []*lidarr.IndexerRelease{{
+				GUID: "abc123",
+				Quality: &starr.Quality{
+					Quality:  &starr.BaseQuality{ID: 6, Name: "FLAC"},
+					Revision: &starr.QualityRevision{Version: 1},
+				},
+				Size:       512000000,
+				IndexerID:  2,
+				Indexer:    "Redacted",
+				Title:      "Artist - Album (2020) [FLAC]",
+				ArtistName: "Artist",
+				AlbumTitle: "Album",
+				Approved:   true,
+				Rejections: []string{},
+				Protocol:   starr.ProtocolTorrent,
+				ArtistID:   4,
+				AlbumID:    5,
+			}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "release") + "?artistId=4",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			WithRequest:    &lidarr.SearchRelease{ArtistID: 4},
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*lidarr.IndexerRelease(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.SearchRelease(test.WithRequest.(*lidarr.SearchRelease))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGrabRelease(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "release"),
+		ExpectedMethod:  http.MethodPost,
+		ExpectedRequest: `{"guid":"abc123","indexerId":2}` + "\n",
+		ResponseStatus:  http.StatusOK,
+		ResponseBody:    `{"guid":"abc123","indexerId":2}`,
+	}
+	mockServer := test.GetMockServer(t)
+	client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+
+	output, err := client.GrabRelease(&lidarr.IndexerRelease{GUID: "abc123", IndexerID: 2, Title: "ignored"})
+	require.NoError(t, err)
+	assert.Equal(t, &lidarr.IndexerRelease{GUID: "abc123", IndexerID: 2}, output)
+}
+
+func TestPushRelease(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, lidarr.APIver, "release", "push"),
+		ExpectedMethod: http.MethodPost,
+		ExpectedRequest: `{"title":"Artist - Album (2020) [FLAC]","downloadUrl":"http://indexer/get/1",` +
+			`"protocol":"torrent","publishDate":"2020-01-01T00:00:00Z","indexer":"Redacted"}` + "\n",
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `{"title":"Artist - Album (2020) [FLAC]","approved":false,"rejections":["Unknown Artist"]}`,
+	}
+	mockServer := test.GetMockServer(t)
+	client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+
+	output, err := client.PushRelease(&lidarr.PushRelease{
+		Title:       "Artist - Album (2020) [FLAC]",
+		DownloadURL: "http://indexer/get/1",
+		Protocol:    starr.ProtocolTorrent,
+		PublishDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Indexer:     "Redacted",
+	})
+	require.NoError(t, err)
+	assert.False(t, output.Approved)
+	assert.Equal(t, []string{"Unknown Artist"}, output.Rejections)
+}
@@ -14,6 +14,15 @@ import (
 
 const bpTrackFile = APIver + "/trackfile"
 
+// TrackFileEditorInput is the input for EditTrackFiles (PUT /trackfile/editor).
+// Every track file in TrackFileIDs gets the same values. Nil members are not changed.
+type TrackFileEditorInput struct {
+	TrackFileIDs []int64        `json:"trackFileIds"`
+	Quality      *starr.Quality `json:"quality,omitempty"`
+	SceneName    *string        `json:"sceneName,omitempty"`
+	ReleaseGroup *string        `json:"releaseGroup,omitempty"`
+}
+
 // TrackFile represents the data sent to and returned from the trackfile endpoint.
 type TrackFile struct {
 	ID            int64          `json:"id"`
@@ -217,3 +226,25 @@ func (l *Lidarr) DeleteTrackFilesContext(ctx context.Context, trackFileIDs []int
 
 	return nil
 }
+
+// EditTrackFiles applies bulk edits to track files. Nil input members are not changed.
+func (l *Lidarr) EditTrackFiles(in *TrackFileEditorInput) ([]*TrackFile, error) {
+	return l.EditTrackFilesContext(context.Background(), in)
+}
+
+// EditTrackFilesContext applies bulk edits to track files. Nil input members are not changed.
+func (l *Lidarr) EditTrackFilesContext(ctx context.Context, in *TrackFileEditorInput) ([]*TrackFile, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(in); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", path.Join(bpTrackFile, "editor"), err)
+	}
+
+	var output []*TrackFile
+
+	req := starr.Request{URI: path.Join(bpTrackFile, "editor"), Body: &body}
+	if err := l.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
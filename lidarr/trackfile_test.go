@@ -0,0 +1,56 @@
+package lidarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestEditTrackFiles(t *testing.T) {
+	t.Parallel()
+
+	group := "FLAC4U"
+	input := &lidarr.TrackFileEditorInput{TrackFileIDs: []int64{21, 22}, ReleaseGroup: &group}
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "trackfile", "editor"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"trackFileIds":[21,22],"releaseGroup":"FLAC4U"}` + "\n",
+			ResponseStatus:  http.StatusAccepted,
+			ResponseBody:    `[{"id":21,"artistId":4,"albumId":8},{"id":22,"artistId":4,"albumId":8}]`,
+			WithResponse: []*lidarr.TrackFile{
+				{ID: 21, ArtistID: 4, AlbumID: 8},
+				{ID: 22, ArtistID: 4, AlbumID: 8},
+			},
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, lidarr.APIver, "trackfile", "editor"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"trackFileIds":[21,22],"releaseGroup":"FLAC4U"}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    ([]*lidarr.TrackFile)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := lidarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditTrackFiles(input)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
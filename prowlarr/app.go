@@ -13,6 +13,13 @@ import (
 
 const bpApplication = APIver + "/applications"
 
+// Application sync levels. These decide what Prowlarr changes in a connected application.
+const (
+	SyncLevelDisabled = "disabled" // Prowlarr does not sync indexers to the application.
+	SyncLevelAddOnly  = "addOnly"  // Indexers are added and updated, but never removed.
+	SyncLevelFull     = "fullSync" // Indexers are added, updated and removed.
+)
+
 // ApplicationInput is used to create or update a connected application.
 type ApplicationInput struct {
 	ID             int64               `json:"id,omitempty"`
@@ -38,6 +45,25 @@ type ApplicationOutput struct {
 	Fields             []*starr.FieldOutput `json:"fields,omitempty"`
 }
 
+// ToInput converts the output of an application into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (a *ApplicationOutput) ToInput() *ApplicationInput {
+	if a == nil {
+		return nil
+	}
+
+	return &ApplicationInput{
+		ID:             a.ID,
+		Name:           a.Name,
+		SyncLevel:      a.SyncLevel,
+		Implementation: a.Implementation,
+		ConfigContract: a.ConfigContract,
+		AppProfileID:   a.AppProfileID,
+		Tags:           a.Tags,
+		Fields:         starr.ToFieldInputs(a.Fields),
+	}
+}
+
 // GetApplications returns all connected applications.
 func (p *Prowlarr) GetApplications() ([]*ApplicationOutput, error) {
 	return p.GetApplicationsContext(context.Background())
@@ -172,3 +198,20 @@ func (p *Prowlarr) TestApplicationContext(ctx context.Context, app *ApplicationI
 
 	return nil
 }
+
+// TestAllApplications tests every connected application.
+func (p *Prowlarr) TestAllApplications() error {
+	return p.TestAllApplicationsContext(context.Background())
+}
+
+// TestAllApplicationsContext tests every connected application.
+func (p *Prowlarr) TestAllApplicationsContext(ctx context.Context) error {
+	var output any
+
+	req := starr.Request{URI: path.Join(bpApplication, "testall")}
+	if err := p.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package prowlarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+)
+
+const (
+	bpConfigHost        = APIver + "/config/host"
+	bpConfigUI          = APIver + "/config/ui"
+	bpConfigDevelopment = APIver + "/config/development"
+)
+
+// HostConfig is the /api/v1/config/host resource.
+type HostConfig struct {
+	ID                        int    `json:"id,omitempty"`
+	BindAddress               string `json:"bindAddress,omitempty"`
+	Port                      int    `json:"port"`
+	SSLPort                   int    `json:"sslPort"`
+	EnableSSL                 bool   `json:"enableSsl"`
+	LaunchBrowser             bool   `json:"launchBrowser"`
+	AuthenticationMethod      string `json:"authenticationMethod,omitempty"`
+	AuthenticationRequired    string `json:"authenticationRequired,omitempty"`
+	AnalyticsEnabled          bool   `json:"analyticsEnabled"`
+	Username                  string `json:"username,omitempty"`
+	Password                  string `json:"password,omitempty"`
+	PasswordConfirmation      string `json:"passwordConfirmation,omitempty"`
+	LogLevel                  string `json:"logLevel,omitempty"`
+	LogSizeLimit              int    `json:"logSizeLimit"`
+	ConsoleLogLevel           string `json:"consoleLogLevel,omitempty"`
+	Branch                    string `json:"branch,omitempty"`
+	APIKey                    string `json:"apiKey,omitempty"`
+	SSLCertPath               string `json:"sslCertPath,omitempty"`
+	SSLCertPassword           string `json:"sslCertPassword,omitempty"`
+	URLBase                   string `json:"urlBase,omitempty"`
+	InstanceName              string `json:"instanceName,omitempty"`
+	ApplicationURL            string `json:"applicationUrl,omitempty"`
+	UpdateAutomatically       bool   `json:"updateAutomatically"`
+	UpdateMechanism           string `json:"updateMechanism,omitempty"`
+	UpdateScriptPath          string `json:"updateScriptPath,omitempty"`
+	ProxyEnabled              bool   `json:"proxyEnabled"`
+	ProxyType                 string `json:"proxyType,omitempty"`
+	ProxyHostname             string `json:"proxyHostname,omitempty"`
+	ProxyPort                 int    `json:"proxyPort"`
+	ProxyUsername             string `json:"proxyUsername,omitempty"`
+	ProxyPassword             string `json:"proxyPassword,omitempty"`
+	ProxyBypassFilter         string `json:"proxyBypassFilter,omitempty"`
+	ProxyBypassLocalAddresses bool   `json:"proxyBypassLocalAddresses"`
+	CertificateValidation     string `json:"certificateValidation,omitempty"`
+	BackupFolder              string `json:"backupFolder,omitempty"`
+	BackupInterval            int    `json:"backupInterval"`
+	BackupRetention           int    `json:"backupRetention"`
+	TrustCgnatIPAddresses     bool   `json:"trustCgnatIpAddresses"`
+	HistoryCleanupDays        int    `json:"historyCleanupDays"`
+}
+
+// UIConfig is the /api/v1/config/ui resource.
+type UIConfig struct {
+	ID                       int    `json:"id,omitempty"`
+	FirstDayOfWeek           int    `json:"firstDayOfWeek"`
+	CalendarWeekColumnHeader string `json:"calendarWeekColumnHeader,omitempty"`
+	ShortDateFormat          string `json:"shortDateFormat,omitempty"`
+	LongDateFormat           string `json:"longDateFormat,omitempty"`
+	TimeFormat               string `json:"timeFormat,omitempty"`
+	ShowRelativeDates        bool   `json:"showRelativeDates"`
+	EnableColorImpairedMode  bool   `json:"enableColorImpairedMode"`
+	Theme                    string `json:"theme,omitempty"`
+	UILanguage               string `json:"uiLanguage,omitempty"`
+}
+
+// DevelopmentConfig is the /api/v1/config/development resource.
+type DevelopmentConfig struct {
+	ID                 int    `json:"id,omitempty"`
+	ConsoleLogLevel    string `json:"consoleLogLevel,omitempty"`
+	LogSQL             bool   `json:"logSql"`
+	LogIndexerResponse bool   `json:"logIndexerResponse"`
+	LogRotate          int    `json:"logRotate"`
+	FilterSentryEvents bool   `json:"filterSentryEvents"`
+}
+
+// GetHostConfig returns the host configuration.
+func (p *Prowlarr) GetHostConfig() (*HostConfig, error) {
+	return p.GetHostConfigContext(context.Background())
+}
+
+// GetHostConfigContext returns the host configuration.
+func (p *Prowlarr) GetHostConfigContext(ctx context.Context) (*HostConfig, error) {
+	var output HostConfig
+
+	req := starr.Request{URI: bpConfigHost}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetHostConfigByID returns the host configuration for the given id.
+func (p *Prowlarr) GetHostConfigByID(id int) (*HostConfig, error) {
+	return p.GetHostConfigByIDContext(context.Background(), id)
+}
+
+// GetHostConfigByIDContext returns the host configuration for the given id.
+func (p *Prowlarr) GetHostConfigByIDContext(ctx context.Context, id int) (*HostConfig, error) {
+	var output HostConfig
+
+	req := starr.Request{URI: path.Join(bpConfigHost, starr.Str(id))}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateHostConfig updates the host configuration.
+func (p *Prowlarr) UpdateHostConfig(input *HostConfig) (*HostConfig, error) {
+	return p.UpdateHostConfigContext(context.Background(), input)
+}
+
+// UpdateHostConfigContext updates the host configuration.
+func (p *Prowlarr) UpdateHostConfigContext(ctx context.Context, input *HostConfig) (*HostConfig, error) {
+	var output HostConfig
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpConfigHost, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpConfigHost, starr.Str(input.ID)), Body: &body}
+	if err := p.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetUIConfig returns the UI configuration.
+func (p *Prowlarr) GetUIConfig() (*UIConfig, error) {
+	return p.GetUIConfigContext(context.Background())
+}
+
+// GetUIConfigContext returns the UI configuration.
+func (p *Prowlarr) GetUIConfigContext(ctx context.Context) (*UIConfig, error) {
+	var output UIConfig
+
+	req := starr.Request{URI: bpConfigUI}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetUIConfigByID returns the UI configuration for the given id.
+func (p *Prowlarr) GetUIConfigByID(id int) (*UIConfig, error) {
+	return p.GetUIConfigByIDContext(context.Background(), id)
+}
+
+// GetUIConfigByIDContext returns the UI configuration for the given id.
+func (p *Prowlarr) GetUIConfigByIDContext(ctx context.Context, id int) (*UIConfig, error) {
+	var output UIConfig
+
+	req := starr.Request{URI: path.Join(bpConfigUI, starr.Str(id))}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateUIConfig updates the UI configuration.
+func (p *Prowlarr) UpdateUIConfig(input *UIConfig) (*UIConfig, error) {
+	return p.UpdateUIConfigContext(context.Background(), input)
+}
+
+// UpdateUIConfigContext updates the UI configuration.
+func (p *Prowlarr) UpdateUIConfigContext(ctx context.Context, input *UIConfig) (*UIConfig, error) {
+	var output UIConfig
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpConfigUI, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpConfigUI, starr.Str(input.ID)), Body: &body}
+	if err := p.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetDevelopmentConfig returns the development configuration.
+func (p *Prowlarr) GetDevelopmentConfig() (*DevelopmentConfig, error) {
+	return p.GetDevelopmentConfigContext(context.Background())
+}
+
+// GetDevelopmentConfigContext returns the development configuration.
+func (p *Prowlarr) GetDevelopmentConfigContext(ctx context.Context) (*DevelopmentConfig, error) {
+	var output DevelopmentConfig
+
+	req := starr.Request{URI: bpConfigDevelopment}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetDevelopmentConfigByID returns the development configuration for the given id.
+func (p *Prowlarr) GetDevelopmentConfigByID(id int) (*DevelopmentConfig, error) {
+	return p.GetDevelopmentConfigByIDContext(context.Background(), id)
+}
+
+// GetDevelopmentConfigByIDContext returns the development configuration for the given id.
+func (p *Prowlarr) GetDevelopmentConfigByIDContext(ctx context.Context, id int) (*DevelopmentConfig, error) {
+	var output DevelopmentConfig
+
+	req := starr.Request{URI: path.Join(bpConfigDevelopment, starr.Str(id))}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateDevelopmentConfig updates the development configuration.
+func (p *Prowlarr) UpdateDevelopmentConfig(input *DevelopmentConfig) (*DevelopmentConfig, error) {
+	return p.UpdateDevelopmentConfigContext(context.Background(), input)
+}
+
+// UpdateDevelopmentConfigContext updates the development configuration.
+func (p *Prowlarr) UpdateDevelopmentConfigContext(
+	ctx context.Context, input *DevelopmentConfig,
+) (*DevelopmentConfig, error) {
+	var output DevelopmentConfig
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpConfigDevelopment, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpConfigDevelopment, starr.Str(input.ID)), Body: &body}
+	if err := p.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
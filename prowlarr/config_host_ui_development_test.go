@@ -0,0 +1,108 @@
+package prowlarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/starrshared"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetHostConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "config", "host"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"id":1,"port":9696,"enableSsl":false,"authenticationMethod":"forms",
+				"authenticationRequired":"enabled","analyticsEnabled":true,"logLevel":"info","logSizeLimit":1,
+				"proxyEnabled":false,"backupInterval":7,"backupRetention":28,"historyCleanupDays":365}`,
+			WithResponse: &prowlarr.HostConfig{
+				ID:                     1,
+				Port:                   9696,
+				AuthenticationMethod:   starrshared.AuthenticationForms,
+				AuthenticationRequired: starrshared.AuthenticationRequiredEnabled,
+				AnalyticsEnabled:       true,
+				LogLevel:               "info",
+				LogSizeLimit:           1,
+				BackupInterval:         7,
+				BackupRetention:        28,
+				HistoryCleanupDays:     365,
+			},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "config", "host"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*prowlarr.HostConfig)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetHostConfig()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestUpdateUIConfig(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "config", "ui", "1"),
+		ExpectedMethod: http.MethodPut,
+		ExpectedRequest: `{"id":1,"firstDayOfWeek":1,"showRelativeDates":true,` +
+			`"enableColorImpairedMode":false,"theme":"dark","uiLanguage":"en"}` + "\n",
+		ResponseStatus: http.StatusAccepted,
+		ResponseBody:   `{"id":1,"firstDayOfWeek":1,"showRelativeDates":true,"theme":"dark","uiLanguage":"en"}`,
+		WithRequest: &prowlarr.UIConfig{
+			ID: 1, FirstDayOfWeek: 1, ShowRelativeDates: true, Theme: "dark", UILanguage: "en",
+		},
+		WithResponse: &prowlarr.UIConfig{
+			ID: 1, FirstDayOfWeek: 1, ShowRelativeDates: true, Theme: "dark", UILanguage: "en",
+		},
+	}
+
+	client := prowlarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	output, err := client.UpdateUIConfig(test.WithRequest.(*prowlarr.UIConfig))
+	require.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+}
+
+func TestUpdateDevelopmentConfig(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "config", "development", "1"),
+		ExpectedMethod: http.MethodPut,
+		ExpectedRequest: `{"id":1,"consoleLogLevel":"debug","logSql":false,"logIndexerResponse":true,` +
+			`"logRotate":50,"filterSentryEvents":true}` + "\n",
+		ResponseStatus: http.StatusAccepted,
+		ResponseBody: `{"id":1,"consoleLogLevel":"debug","logSql":false,"logIndexerResponse":true,` +
+			`"logRotate":50,"filterSentryEvents":true}`,
+		WithRequest: &prowlarr.DevelopmentConfig{
+			ID: 1, ConsoleLogLevel: "debug", LogIndexerResponse: true, LogRotate: 50, FilterSentryEvents: true,
+		},
+	}
+
+	client := prowlarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	output, err := client.UpdateDevelopmentConfig(test.WithRequest.(*prowlarr.DevelopmentConfig))
+	require.NoError(t, err)
+	assert.EqualValues(t, test.WithRequest, output)
+}
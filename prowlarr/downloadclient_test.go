@@ -490,3 +490,43 @@ func TestDeleteDownloadClient(t *testing.T) {
 		})
 	}
 }
+
+const applicationResponseBody = `{
+    "id": 1,
+    "name": "Comics",
+    "syncLevel": "fullSync",
+    "implementation": "Mylar",
+    "implementationName": "Mylar",
+    "configContract": "MylarSettings",
+    "appProfileId": 2,
+    "tags": [1],
+    "fields": [{"order": 0, "name": "baseUrl", "label": "Mylar Server", "value": "http://mylar:8090", "type": "textbox"}]
+}`
+
+const indexerProxyResponseBody = `{
+    "id": 1,
+    "name": "Flare",
+    "implementation": "FlareSolverr",
+    "implementationName": "FlareSolverr",
+    "configContract": "FlareSolverrSettings",
+    "fields": [{"order": 0, "name": "host", "label": "Host", "value": "http://flare:8191/", "type": "textbox"}]
+}`
+
+func TestOutputToInput(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(*testing.T){
+		"Application":    starrtest.ToInputCase((*prowlarr.ApplicationOutput).ToInput, applicationResponseBody),
+		"DownloadClient": starrtest.ToInputCase((*prowlarr.DownloadClientOutput).ToInput, downloadClientResponseBody),
+		"Indexer":        starrtest.ToInputCase((*prowlarr.IndexerOutput).ToInput, indexerResponseBody),
+		"IndexerProxy":   starrtest.ToInputCase((*prowlarr.IndexerProxyOutput).ToInput, indexerProxyResponseBody),
+		"Notification":   starrtest.ToInputCase((*prowlarr.NotificationOutput).ToInput, notificationResponseBody),
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			test(t)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package prowlarr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpFilesystem = APIver + "/filesystem"
+
+// FilesystemQuery is the query for /api/v1/filesystem.
+type FilesystemQuery = starrshared.FilesystemQuery
+
+// BrowseFilesystem lists files and folders for a path.
+func (p *Prowlarr) BrowseFilesystem(query *FilesystemQuery) ([]*starr.Path, error) {
+	return p.BrowseFilesystemContext(context.Background(), query)
+}
+
+// BrowseFilesystemContext lists files and folders for a path.
+func (p *Prowlarr) BrowseFilesystemContext(ctx context.Context, query *FilesystemQuery) ([]*starr.Path, error) {
+	var output []*starr.Path
+
+	req := starr.Request{URI: bpFilesystem, Query: query.Values()}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetFilesystemType returns the raw response body from /api/v1/filesystem/type.
+func (p *Prowlarr) GetFilesystemType(pathName string) ([]byte, error) {
+	return p.GetFilesystemTypeContext(context.Background(), pathName)
+}
+
+// GetFilesystemTypeContext returns the raw response body from /api/v1/filesystem/type.
+func (p *Prowlarr) GetFilesystemTypeContext(ctx context.Context, pathName string) ([]byte, error) {
+	params := make(url.Values)
+	params.Set("path", pathName)
+
+	req := starr.Request{URI: starr.SetAPIPath(path.Join(bpFilesystem, "type")), Query: params}
+
+	resp, err := p.Get(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading HTTP response body: %w", err)
+	}
+
+	return body, nil
+}
@@ -0,0 +1,86 @@
+package prowlarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestBrowseFilesystem(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, prowlarr.APIver, "filesystem") +
+				"?allowFoldersWithoutTrailingSlashes=false&includeFiles=true&path=%2Fconfig%2F",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"name":"Backups","path":"/config/Backups/"}]`,
+			WithRequest:    &prowlarr.FilesystemQuery{Path: "/config/", IncludeFiles: true},
+			WithResponse:   []*starr.Path{{Name: "Backups", Path: "/config/Backups/"}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "filesystem"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    (*prowlarr.FilesystemQuery)(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*starr.Path(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.BrowseFilesystem(test.WithRequest.(*prowlarr.FilesystemQuery))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetFilesystemType(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "filesystem", "type") + "?path=%2Fconfig",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"type":"folder"}`,
+			WithResponse:   []byte(`{"type":"folder"}`),
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "filesystem", "type") + "?path=%2Fconfig",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []byte(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetFilesystemType("/config")
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.Equal(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -58,6 +58,28 @@ type IndexerOutput struct {
 	Fields             []*starr.FieldOutput `json:"fields"`
 }
 
+// ToInput converts the output of an indexer into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (i *IndexerOutput) ToInput() *IndexerInput {
+	if i == nil {
+		return nil
+	}
+
+	return &IndexerInput{
+		Enable:         i.Enable,
+		Redirect:       i.Redirect,
+		Priority:       i.Priority,
+		ID:             i.ID,
+		AppProfileID:   i.AppProfileID,
+		ConfigContract: i.ConfigContract,
+		Implementation: i.Implementation,
+		Name:           i.Name,
+		Protocol:       i.Protocol,
+		Tags:           i.Tags,
+		Fields:         starr.ToFieldInputs(i.Fields),
+	}
+}
+
 // Capabilities is part of IndexerOutput.
 type Capabilities struct {
 	SupportsRawSearch bool          `json:"supportsRawSearch"`
@@ -32,6 +32,22 @@ type IndexerProxyOutput struct {
 	Fields             []*starr.FieldOutput `json:"fields,omitempty"`
 }
 
+// ToInput converts the output of an indexer proxy into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (p *IndexerProxyOutput) ToInput() *IndexerProxyInput {
+	if p == nil {
+		return nil
+	}
+
+	return &IndexerProxyInput{
+		ID:             p.ID,
+		Name:           p.Name,
+		Implementation: p.Implementation,
+		ConfigContract: p.ConfigContract,
+		Fields:         starr.ToFieldInputs(p.Fields),
+	}
+}
+
 // GetIndexerProxies returns all indexer proxies.
 func (p *Prowlarr) GetIndexerProxies() ([]*IndexerProxyOutput, error) {
 	return p.GetIndexerProxiesContext(context.Background())
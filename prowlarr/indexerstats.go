@@ -0,0 +1,155 @@
+package prowlarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golift.io/starr"
+)
+
+const (
+	bpIndexerStats  = APIver + "/indexerstats"
+	bpIndexerStatus = APIver + "/indexerstatus"
+)
+
+// IndexerStats is the /api/v1/indexerstats endpoint.
+type IndexerStats struct {
+	ID         int64                  `json:"id"`
+	Indexers   []*IndexerStatistics   `json:"indexers"`
+	UserAgents []*UserAgentStatistics `json:"userAgents"`
+	Hosts      []*HostStatistics      `json:"hosts"`
+}
+
+// IndexerStatistics is the query and grab counts for one indexer. Response times are in milliseconds.
+type IndexerStatistics struct {
+	IndexerID                 int64  `json:"indexerId"`
+	IndexerName               string `json:"indexerName"`
+	AverageResponseTime       int64  `json:"averageResponseTime"`
+	AverageGrabResponseTime   int64  `json:"averageGrabResponseTime"`
+	NumberOfQueries           int64  `json:"numberOfQueries"`
+	NumberOfGrabs             int64  `json:"numberOfGrabs"`
+	NumberOfRssQueries        int64  `json:"numberOfRssQueries"`
+	NumberOfAuthQueries       int64  `json:"numberOfAuthQueries"`
+	NumberOfFailedQueries     int64  `json:"numberOfFailedQueries"`
+	NumberOfFailedGrabs       int64  `json:"numberOfFailedGrabs"`
+	NumberOfFailedRssQueries  int64  `json:"numberOfFailedRssQueries"`
+	NumberOfFailedAuthQueries int64  `json:"numberOfFailedAuthQueries"`
+}
+
+// UserAgentStatistics is the query and grab counts for one application user agent.
+type UserAgentStatistics struct {
+	UserAgent       string `json:"userAgent"`
+	NumberOfQueries int64  `json:"numberOfQueries"`
+	NumberOfGrabs   int64  `json:"numberOfGrabs"`
+}
+
+// HostStatistics is the query and grab counts for one host.
+type HostStatistics struct {
+	Host            string `json:"host"`
+	NumberOfQueries int64  `json:"numberOfQueries"`
+	NumberOfGrabs   int64  `json:"numberOfGrabs"`
+}
+
+// IndexerStatsInput filters the indexer statistics. All members are optional.
+type IndexerStatsInput struct {
+	StartDate time.Time
+	EndDate   time.Time
+	// Only include these indexer IDs.
+	Indexers []int64
+	// Only include indexers using these protocols.
+	Protocols []starr.Protocol
+	// Only include indexers with these tag IDs.
+	Tags []int
+}
+
+// IndexerStatus is the /api/v1/indexerstatus endpoint.
+// Only indexers that have failed recently have a status.
+type IndexerStatus struct {
+	ID                int64     `json:"id"`
+	IndexerID         int64     `json:"indexerId"`
+	DisabledTill      time.Time `json:"disabledTill,omitzero"`
+	MostRecentFailure time.Time `json:"mostRecentFailure,omitzero"`
+	InitialFailure    time.Time `json:"initialFailure,omitzero"`
+}
+
+// GetIndexerStats returns indexer, user agent and host statistics. Input may be nil.
+func (p *Prowlarr) GetIndexerStats(input *IndexerStatsInput) (*IndexerStats, error) {
+	return p.GetIndexerStatsContext(context.Background(), input)
+}
+
+// GetIndexerStatsContext returns indexer, user agent and host statistics. Input may be nil.
+func (p *Prowlarr) GetIndexerStatsContext(ctx context.Context, input *IndexerStatsInput) (*IndexerStats, error) {
+	var output IndexerStats
+
+	req := starr.Request{URI: bpIndexerStats, Query: input.values()}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+func (i *IndexerStatsInput) values() url.Values {
+	params := make(url.Values)
+	if i == nil {
+		return params
+	}
+
+	if !i.StartDate.IsZero() {
+		params.Set("startDate", i.StartDate.UTC().Format(time.RFC3339))
+	}
+
+	if !i.EndDate.IsZero() {
+		params.Set("endDate", i.EndDate.UTC().Format(time.RFC3339))
+	}
+
+	if len(i.Indexers) > 0 {
+		indexers := make([]string, len(i.Indexers))
+		for idx, indexer := range i.Indexers {
+			indexers[idx] = starr.Str(indexer)
+		}
+
+		params.Set("indexers", strings.Join(indexers, ","))
+	}
+
+	if len(i.Protocols) > 0 {
+		protocols := make([]string, len(i.Protocols))
+		for idx, protocol := range i.Protocols {
+			protocols[idx] = string(protocol)
+		}
+
+		params.Set("protocols", strings.Join(protocols, ","))
+	}
+
+	if len(i.Tags) > 0 {
+		tags := make([]string, len(i.Tags))
+		for idx, tag := range i.Tags {
+			tags[idx] = strconv.Itoa(tag)
+		}
+
+		params.Set("tags", strings.Join(tags, ","))
+	}
+
+	return params
+}
+
+// GetIndexerStatuses returns the failure status of indexers that failed recently.
+func (p *Prowlarr) GetIndexerStatuses() ([]*IndexerStatus, error) {
+	return p.GetIndexerStatusesContext(context.Background())
+}
+
+// GetIndexerStatusesContext returns the failure status of indexers that failed recently.
+func (p *Prowlarr) GetIndexerStatusesContext(ctx context.Context) ([]*IndexerStatus, error) {
+	var output []*IndexerStatus
+
+	req := starr.Request{URI: bpIndexerStatus}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,154 @@
+package prowlarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/starrtest"
+)
+
+const indexerStatsBody = `{
+  "id": 1,
+  "indexers": [
+    {
+      "indexerId": 3,
+      "indexerName": "Nyaa",
+      "averageResponseTime": 412,
+      "averageGrabResponseTime": 230,
+      "numberOfQueries": 120,
+      "numberOfGrabs": 14,
+      "numberOfRssQueries": 60,
+      "numberOfAuthQueries": 2,
+      "numberOfFailedQueries": 5,
+      "numberOfFailedGrabs": 1,
+      "numberOfFailedRssQueries": 3,
+      "numberOfFailedAuthQueries": 0
+    }
+  ],
+  "userAgents": [{"userAgent": "Mozilla/5.0", "numberOfQueries": 90, "numberOfGrabs": 10}],
+  "hosts": [{"host": "localhost", "numberOfQueries": 120, "numberOfGrabs": 14}]
+}`
+
+var indexerStats = &prowlarr.IndexerStats{
+	ID: 1,
+	Indexers: []*prowlarr.IndexerStatistics{{
+		IndexerID:                3,
+		IndexerName:              "Nyaa",
+		AverageResponseTime:      412,
+		AverageGrabResponseTime:  230,
+		NumberOfQueries:          120,
+		NumberOfGrabs:            14,
+		NumberOfRssQueries:       60,
+		NumberOfAuthQueries:      2,
+		NumberOfFailedQueries:    5,
+		NumberOfFailedGrabs:      1,
+		NumberOfFailedRssQueries: 3,
+	}},
+	UserAgents: []*prowlarr.UserAgentStatistics{{UserAgent: "Mozilla/5.0", NumberOfQueries: 90, NumberOfGrabs: 10}},
+	Hosts:      []*prowlarr.HostStatistics{{Host: "localhost", NumberOfQueries: 120, NumberOfGrabs: 14}},
+}
+
+func TestGetIndexerStats(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexerstats"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   indexerStatsBody,
+			WithRequest:    (*prowlarr.IndexerStatsInput)(nil),
+			WithResponse:   indexerStats,
+		},
+		{
+			Name: "200 filtered",
+			ExpectedPath: path.Join("/", starr.API, prowlarr.APIver, "indexerstats") +
+				"?endDate=2026-04-12T00%3A00%3A00Z&indexers=3%2C4&protocols=torrent&startDate=2026-04-05T00%3A00%3A00Z&tags=1%2C2",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   indexerStatsBody,
+			WithRequest: &prowlarr.IndexerStatsInput{
+				StartDate: time.Date(2026, 4, 5, 2, 0, 0, 0, time.FixedZone("CEST", 7200)),
+				EndDate:   time.Date(2026, 4, 12, 0, 0, 0, 0, time.UTC),
+				Indexers:  []int64{3, 4},
+				Protocols: []starr.Protocol{starr.ProtocolTorrent},
+				Tags:      []int{1, 2},
+			},
+			WithResponse: indexerStats,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexerstats"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    (*prowlarr.IndexerStatsInput)(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*prowlarr.IndexerStats)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetIndexerStats(test.WithRequest.(*prowlarr.IndexerStatsInput))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetIndexerStatuses(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexerstatus"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id": 2, "indexerId": 3, "disabledTill": "2026-04-12T10:15:00Z",
+				"mostRecentFailure": "2026-04-12T10:00:00Z", "initialFailure": "2026-04-12T09:00:00Z"},
+				{"id": 3, "indexerId": 4, "disabledTill": null, "mostRecentFailure": null, "initialFailure": null}]`,
+			WithResponse: []*prowlarr.IndexerStatus{
+				{
+					ID:                2,
+					IndexerID:         3,
+					DisabledTill:      time.Date(2026, 4, 12, 10, 15, 0, 0, time.UTC),
+					MostRecentFailure: time.Date(2026, 4, 12, 10, 0, 0, 0, time.UTC),
+					InitialFailure:    time.Date(2026, 4, 12, 9, 0, 0, 0, time.UTC),
+				},
+				{ID: 3, IndexerID: 4},
+			},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexerstatus"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*prowlarr.IndexerStatus)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetIndexerStatuses()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package prowlarr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpLog = APIver + "/log"
+
+// LogLine is one record from /api/v1/log.
+type LogLine = starrshared.LogLine
+
+// LogPage is a page of log lines from /api/v1/log.
+type LogPage = starrshared.LogPage
+
+// LogFile describes a log file on disk.
+type LogFile = starrshared.LogFile
+
+// GetLogPage returns a page of application log lines.
+// Set a "level" value in params to filter the lines by log level.
+func (p *Prowlarr) GetLogPage(params *starr.PageReq) (*LogPage, error) {
+	return p.GetLogPageContext(context.Background(), params)
+}
+
+// GetLogPageContext returns a page of application log lines.
+// Set a "level" value in params to filter the lines by log level.
+func (p *Prowlarr) GetLogPageContext(ctx context.Context, params *starr.PageReq) (*LogPage, error) {
+	var output LogPage
+
+	req := starr.Request{URI: bpLog, Query: params.Params()}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetLogFiles returns the list of log files.
+func (p *Prowlarr) GetLogFiles() ([]*LogFile, error) {
+	return p.GetLogFilesContext(context.Background())
+}
+
+// GetLogFilesContext returns the list of log files.
+func (p *Prowlarr) GetLogFilesContext(ctx context.Context) ([]*LogFile, error) {
+	return p.getLogFiles(ctx, path.Join(bpLog, "file"))
+}
+
+// GetLogFile returns the contents of a named log file.
+// Use OpenLogFile for large files.
+func (p *Prowlarr) GetLogFile(filename string) (*LogFile, error) {
+	return p.GetLogFileContext(context.Background(), filename)
+}
+
+// GetLogFileContext returns the contents of a named log file.
+// Use OpenLogFileContext for large files.
+func (p *Prowlarr) GetLogFileContext(ctx context.Context, filename string) (*LogFile, error) {
+	return p.getLogFile(ctx, path.Join(bpLog, "file"), filename)
+}
+
+// OpenLogFile returns a reader for a named log file, so large files can be streamed.
+// Close the reader when finished with it.
+func (p *Prowlarr) OpenLogFile(filename string) (io.ReadCloser, error) {
+	return p.OpenLogFileContext(context.Background(), filename)
+}
+
+// OpenLogFileContext returns a reader for a named log file, so large files can be streamed.
+// Close the reader when finished with it.
+func (p *Prowlarr) OpenLogFileContext(ctx context.Context, filename string) (io.ReadCloser, error) {
+	return p.openLogFile(ctx, path.Join(bpLog, "file", filename))
+}
+
+// GetUpdateLogFiles returns the list of update log files.
+func (p *Prowlarr) GetUpdateLogFiles() ([]*LogFile, error) {
+	return p.GetUpdateLogFilesContext(context.Background())
+}
+
+// GetUpdateLogFilesContext returns the list of update log files.
+func (p *Prowlarr) GetUpdateLogFilesContext(ctx context.Context) ([]*LogFile, error) {
+	return p.getLogFiles(ctx, path.Join(bpLog, "file", "update"))
+}
+
+// GetUpdateLogFile returns the contents of a named update log file.
+func (p *Prowlarr) GetUpdateLogFile(filename string) (*LogFile, error) {
+	return p.GetUpdateLogFileContext(context.Background(), filename)
+}
+
+// GetUpdateLogFileContext returns the contents of a named update log file.
+func (p *Prowlarr) GetUpdateLogFileContext(ctx context.Context, filename string) (*LogFile, error) {
+	return p.getLogFile(ctx, path.Join(bpLog, "file", "update"), filename)
+}
+
+// OpenUpdateLogFile returns a reader for a named update log file. Close the reader when finished with it.
+func (p *Prowlarr) OpenUpdateLogFile(filename string) (io.ReadCloser, error) {
+	return p.OpenUpdateLogFileContext(context.Background(), filename)
+}
+
+// OpenUpdateLogFileContext returns a reader for a named update log file. Close the reader when finished with it.
+func (p *Prowlarr) OpenUpdateLogFileContext(ctx context.Context, filename string) (io.ReadCloser, error) {
+	return p.openLogFile(ctx, path.Join(bpLog, "file", "update", filename))
+}
+
+func (p *Prowlarr) getLogFiles(ctx context.Context, uri string) ([]*LogFile, error) {
+	var output []*LogFile
+
+	req := starr.Request{URI: uri}
+	if err := p.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+func (p *Prowlarr) getLogFile(ctx context.Context, uri, filename string) (*LogFile, error) {
+	body, err := p.openLogFile(ctx, path.Join(uri, filename))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	contents, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("reading log file %s: %w", filename, err)
+	}
+
+	return &LogFile{Filename: filename, Contents: string(contents)}, nil
+}
+
+// openLogFile returns the body of a log file. The apps serve log files as plain text, not json.
+func (p *Prowlarr) openLogFile(ctx context.Context, uri string) (io.ReadCloser, error) {
+	req := starr.Request{URI: starr.SetAPIPath(uri)}
+
+	resp, err := p.Get(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return resp.Body, nil
+}
@@ -0,0 +1,144 @@
+package prowlarr_test
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetLogPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, prowlarr.APIver, "log") +
+				"?level=error&page=1&pageSize=10&sortDirection=descending&sortKey=time",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `{"page":1,"pageSize":10,"sortKey":"time","sortDirection":"descending","totalRecords":1,
+				"records":[{"id":5,"time":"2026-04-12T10:00:00Z","level":"error","logger":"Api","message":"boom"}]}`,
+			WithRequest: &starr.PageReq{
+				SortKey: "time", SortDir: starr.SortDescend, Values: url.Values{"level": {"error"}},
+			},
+			WithResponse: &prowlarr.LogPage{
+				Page: 1, PageSize: 10, SortKey: "time", SortDirection: "descending", TotalRecords: 1,
+				Records: []*prowlarr.LogLine{{
+					ID: 5, Time: time.Date(2026, 4, 12, 10, 0, 0, 0, time.UTC), Level: "error", Logger: "Api", Message: "boom",
+				}},
+			},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "log") + "?page=1&pageSize=10&sortDirection=ascending&sortKey=date",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    &starr.PageReq{},
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*prowlarr.LogPage)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetLogPage(test.WithRequest.(*starr.PageReq))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetLogFiles(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "log", "file", "update"),
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody: `[{"id":1,"filename":"Prowlarr.txt","lastWriteTime":"2026-04-12T10:00:00Z",` +
+			`"contentsUrl":"/api/v1/log/file/update/Prowlarr.txt","downloadUrl":"/updatelogfile/Prowlarr.txt"}]`,
+		WithResponse: []*prowlarr.LogFile{{
+			ID:          1,
+			Filename:    "Prowlarr.txt",
+			LastWrite:   time.Date(2026, 4, 12, 10, 0, 0, 0, time.UTC),
+			ContentsURL: "/api/v1/log/file/update/Prowlarr.txt",
+			DownloadURL: "/updatelogfile/Prowlarr.txt",
+		}},
+	}
+
+	client := prowlarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	output, err := client.GetUpdateLogFiles()
+	require.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+}
+
+func TestGetLogFile(t *testing.T) {
+	t.Parallel()
+
+	const contents = "2026-04-12 10:00:00.0|Info|Bootstrap|Starting\n2026-04-12 10:00:01.0|Error|Api|boom\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "log", "file", "Prowlarr.txt"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   contents,
+			WithResponse:   &prowlarr.LogFile{Filename: "Prowlarr.txt", Contents: contents},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "log", "file", "Prowlarr.txt"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*prowlarr.LogFile)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetLogFile("Prowlarr.txt")
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+
+	t.Run("stream", func(t *testing.T) {
+		t.Parallel()
+
+		test := &starrtest.MockData{
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "log", "file", "update", "Prowlarr.txt"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   contents,
+		}
+		client := prowlarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+
+		reader, err := client.OpenUpdateLogFile("Prowlarr.txt")
+		require.NoError(t, err)
+
+		defer reader.Close()
+
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, contents, string(data))
+	})
+}
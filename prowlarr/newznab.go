@@ -0,0 +1,372 @@
+package prowlarr
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"golift.io/starr"
+)
+
+/* Prowlarr serves every indexer as a Newznab (usenet) or Torznab (torrent) feed, like the other apps use them. */
+
+// ErrNewznab is returned when an indexer feed answers with a Newznab error document.
+var ErrNewznab = errors.New("newznab error")
+
+// NewznabMode is the search function (the t parameter) of a Newznab or Torznab request.
+type NewznabMode string
+
+// Newznab and Torznab search modes. Check the indexer's capabilities for the modes it supports.
+const (
+	NewznabModeSearch NewznabMode = "search"
+	NewznabModeTV     NewznabMode = "tvsearch"
+	NewznabModeMovie  NewznabMode = "movie"
+	NewznabModeMusic  NewznabMode = "music"
+	NewznabModeBook   NewznabMode = "book"
+)
+
+// NewznabCaps is the capabilities (t=caps) of an indexer feed.
+type NewznabCaps struct {
+	Server     NewznabServer
+	MaxLimit   int
+	Limit      int // Default result limit.
+	Searching  map[NewznabMode]*NewznabSearching
+	Categories []*Category
+}
+
+// NewznabServer is the server information in an indexer's capabilities.
+type NewznabServer struct {
+	Version   string `xml:"version,attr"`
+	Title     string `xml:"title,attr"`
+	Strapline string `xml:"strapline,attr"`
+	Email     string `xml:"email,attr"`
+	URL       string `xml:"url,attr"`
+	Image     string `xml:"image,attr"`
+}
+
+// NewznabSearching is the support an indexer has for one search mode.
+type NewznabSearching struct {
+	Available       bool
+	SupportedParams []string
+}
+
+// NewznabQuery is the input to NewznabSearch. Only non-zero members are sent.
+type NewznabQuery struct {
+	Mode       NewznabMode // Defaults to NewznabModeSearch.
+	Query      string
+	Categories []int64
+	Limit      int
+	Offset     int
+	Extended   bool // Request all of the extra attributes.
+	MinAge     int  // in days.
+	MaxAge     int  // in days.
+	MinSize    int64
+	MaxSize    int64
+	// TV and movie searches.
+	IMDbID   string
+	TMDbID   int64
+	TVDbID   int64
+	TVMazeID int64
+	TraktID  int64
+	RageID   int64
+	DoubanID int64
+	Season   int
+	Episode  string
+	Year     int
+	Genre    string
+	// Music searches.
+	Artist string
+	Album  string
+	Label  string
+	Track  string
+	// Book searches.
+	Author    string
+	Title     string
+	Publisher string
+}
+
+// NewznabResults is the output from NewznabSearch.
+type NewznabResults struct {
+	Offset int
+	Total  int
+	Items  []*NewznabItem
+}
+
+// NewznabItem is one release in a Newznab or Torznab feed.
+type NewznabItem struct {
+	Title       string
+	GUID        string
+	Link        string // Download link.
+	Comments    string
+	Description string
+	PublishDate time.Time
+	Size        int64
+	// Categories only have an ID. Match them to the indexer's capabilities for their names.
+	Categories []*Category
+	Enclosure  NewznabEnclosure
+	// Attributes holds the newznab:attr and torznab:attr values, like seeders, infohash or imdbid.
+	// Categories are not included.
+	Attributes map[string]string
+}
+
+// NewznabEnclosure is the download of a Newznab or Torznab item.
+type NewznabEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+// newznabCaps is the caps xml document.
+type newznabCaps struct {
+	Server NewznabServer `xml:"server"`
+	Limits struct {
+		Max     int `xml:"max,attr"`
+		Default int `xml:"default,attr"`
+	} `xml:"limits"`
+	Searching struct {
+		Modes []struct {
+			XMLName         xml.Name
+			Available       string `xml:"available,attr"`
+			SupportedParams string `xml:"supportedParams,attr"`
+		} `xml:",any"`
+	} `xml:"searching"`
+	Categories []*newznabCategory `xml:"categories>category"`
+}
+
+type newznabCategory struct {
+	ID      int64              `xml:"id,attr"`
+	Name    string             `xml:"name,attr"`
+	Subcats []*newznabCategory `xml:"subcat"`
+}
+
+// newznabRSS is the search results xml document.
+type newznabRSS struct {
+	Response struct {
+		Offset int `xml:"offset,attr"`
+		Total  int `xml:"total,attr"`
+	} `xml:"channel>response"`
+	Items []*struct {
+		Title       string           `xml:"title"`
+		GUID        string           `xml:"guid"`
+		Link        string           `xml:"link"`
+		Comments    string           `xml:"comments"`
+		Description string           `xml:"description"`
+		PubDate     string           `xml:"pubDate"`
+		Size        int64            `xml:"size"`
+		Enclosure   NewznabEnclosure `xml:"enclosure"`
+		Attrs       []struct {
+			Name  string `xml:"name,attr"`
+			Value string `xml:"value,attr"`
+		} `xml:"attr"`
+	} `xml:"channel>item"`
+}
+
+// newznabError is the error xml document.
+type newznabError struct {
+	XMLName     xml.Name
+	Code        int    `xml:"code,attr"`
+	Description string `xml:"description,attr"`
+}
+
+// GetNewznabCaps returns the capabilities of an indexer's Newznab or Torznab feed.
+func (p *Prowlarr) GetNewznabCaps(indexerID int64) (*NewznabCaps, error) {
+	return p.GetNewznabCapsContext(context.Background(), indexerID)
+}
+
+// GetNewznabCapsContext returns the capabilities of an indexer's Newznab or Torznab feed.
+func (p *Prowlarr) GetNewznabCapsContext(ctx context.Context, indexerID int64) (*NewznabCaps, error) {
+	var caps newznabCaps
+	if err := p.getNewznab(ctx, indexerID, url.Values{"t": {"caps"}}, &caps); err != nil {
+		return nil, err
+	}
+
+	output := &NewznabCaps{
+		Server:     caps.Server,
+		MaxLimit:   caps.Limits.Max,
+		Limit:      caps.Limits.Default,
+		Searching:  make(map[NewznabMode]*NewznabSearching),
+		Categories: newznabCategories(caps.Categories),
+	}
+
+	for _, mode := range caps.Searching.Modes {
+		name := newznabCapsMode(mode.XMLName.Local)
+		if name == "" {
+			continue // audio-search is an old name for music-search.
+		}
+
+		output.Searching[name] = &NewznabSearching{Available: mode.Available == "yes"}
+		if mode.SupportedParams != "" {
+			output.Searching[name].SupportedParams = strings.Split(mode.SupportedParams, ",")
+		}
+	}
+
+	return output, nil
+}
+
+// newznabCapsMode returns the search mode for a searching element in the caps document.
+func newznabCapsMode(element string) NewznabMode {
+	switch element {
+	case "search":
+		return NewznabModeSearch
+	case "tv-search":
+		return NewznabModeTV
+	case "movie-search":
+		return NewznabModeMovie
+	case "music-search":
+		return NewznabModeMusic
+	case "book-search":
+		return NewznabModeBook
+	default:
+		return ""
+	}
+}
+
+func newznabCategories(input []*newznabCategory) []*Category {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]*Category, len(input))
+	for idx, cat := range input {
+		output[idx] = &Category{ID: cat.ID, Name: cat.Name, SubCategories: newznabCategories(cat.Subcats)}
+	}
+
+	return output
+}
+
+// NewznabSearch searches an indexer through its Newznab or Torznab feed.
+// This is the same search the other apps make when Prowlarr syncs an indexer to them.
+func (p *Prowlarr) NewznabSearch(indexerID int64, query *NewznabQuery) (*NewznabResults, error) {
+	return p.NewznabSearchContext(context.Background(), indexerID, query)
+}
+
+// NewznabSearchContext searches an indexer through its Newznab or Torznab feed.
+// This is the same search the other apps make when Prowlarr syncs an indexer to them.
+func (p *Prowlarr) NewznabSearchContext(
+	ctx context.Context, indexerID int64, query *NewznabQuery,
+) (*NewznabResults, error) {
+	var rss newznabRSS
+	if err := p.getNewznab(ctx, indexerID, query.values(), &rss); err != nil {
+		return nil, err
+	}
+
+	output := &NewznabResults{
+		Offset: rss.Response.Offset,
+		Total:  rss.Response.Total,
+		Items:  make([]*NewznabItem, len(rss.Items)),
+	}
+
+	for idx, item := range rss.Items {
+		output.Items[idx] = &NewznabItem{
+			Title:       item.Title,
+			GUID:        item.GUID,
+			Link:        item.Link,
+			Comments:    item.Comments,
+			Description: item.Description,
+			PublishDate: newznabDate(item.PubDate),
+			Size:        item.Size,
+			Enclosure:   item.Enclosure,
+			Attributes:  make(map[string]string),
+		}
+
+		for _, attr := range item.Attrs {
+			if attr.Name != "category" {
+				output.Items[idx].Attributes[attr.Name] = attr.Value
+			} else if id, err := strconv.ParseInt(attr.Value, 10, 64); err == nil {
+				output.Items[idx].Categories = append(output.Items[idx].Categories, &Category{ID: id})
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func newznabDate(date string) time.Time {
+	for _, layout := range []string{time.RFC1123Z, time.RFC1123} {
+		if parsed, err := time.Parse(layout, date); err == nil {
+			return parsed.UTC()
+		}
+	}
+
+	return time.Time{}
+}
+
+func (n *NewznabQuery) values() url.Values {
+	if n == nil {
+		n = &NewznabQuery{}
+	}
+
+	params := url.Values{"t": {string(n.Mode)}}
+	if n.Mode == "" {
+		params.Set("t", string(NewznabModeSearch))
+	}
+
+	if len(n.Categories) > 0 {
+		cats := make([]string, len(n.Categories))
+		for idx, cat := range n.Categories {
+			cats[idx] = starr.Str(cat)
+		}
+
+		params.Set("cat", strings.Join(cats, ","))
+	}
+
+	if n.Extended {
+		params.Set("extended", "1")
+	}
+
+	for key, val := range map[string]string{
+		"q": n.Query, "imdbid": n.IMDbID, "ep": n.Episode, "genre": n.Genre, "artist": n.Artist, "album": n.Album,
+		"label": n.Label, "track": n.Track, "author": n.Author, "title": n.Title, "publisher": n.Publisher,
+	} {
+		if val != "" {
+			params.Set(key, val)
+		}
+	}
+
+	for key, val := range map[string]int64{
+		"limit": int64(n.Limit), "offset": int64(n.Offset), "minage": int64(n.MinAge), "maxage": int64(n.MaxAge),
+		"minsize": n.MinSize, "maxsize": n.MaxSize, "tmdbid": n.TMDbID, "tvdbid": n.TVDbID, "tvmazeid": n.TVMazeID,
+		"traktid": n.TraktID, "rid": n.RageID, "doubanid": n.DoubanID, "season": int64(n.Season), "year": int64(n.Year),
+	} {
+		if val != 0 {
+			params.Set(key, starr.Str(val))
+		}
+	}
+
+	return params
+}
+
+// getNewznab makes a request to an indexer feed, and decodes the xml response into output.
+func (p *Prowlarr) getNewznab(ctx context.Context, indexerID int64, params url.Values, output any) error {
+	req := starr.Request{URI: path.Join("/", starr.API, bpIndexer, starr.Str(indexerID), "newznab"), Query: params}
+
+	resp, err := p.Get(ctx, req)
+	if err != nil {
+		return fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response body from %s: %w", &req, err)
+	}
+
+	// The feeds answer errors with a 200 and an error document.
+	var feedErr newznabError
+	if err := xml.Unmarshal(body, &feedErr); err == nil && feedErr.XMLName.Local == "error" {
+		return fmt.Errorf("%w %d: %s", ErrNewznab, feedErr.Code, feedErr.Description)
+	}
+
+	if err := xml.Unmarshal(body, output); err != nil {
+		return fmt.Errorf("xml.Unmarshal(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package prowlarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/starrtest"
+)
+
+const newznabCapsBody = `<?xml version="1.0" encoding="UTF-8"?>
+<caps>
+  <server title="Prowlarr" />
+  <limits default="100" max="100" />
+  <searching>
+    <search available="yes" supportedParams="q" />
+    <tv-search available="yes" supportedParams="q,season,ep,imdbid,tvdbid" />
+    <movie-search available="yes" supportedParams="q,imdbid,tmdbid" />
+    <music-search available="no" supportedParams="" />
+    <audio-search available="no" supportedParams="" />
+    <book-search available="yes" supportedParams="q,author,title" />
+  </searching>
+  <categories>
+    <category id="2000" name="Movies">
+      <subcat id="2040" name="Movies/HD" />
+    </category>
+    <category id="5000" name="TV" />
+  </categories>
+</caps>`
+
+const newznabSearchBody = `<?xml version="1.0" encoding="UTF-8"?>
+<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom" xmlns:torznab="http://torznab.com/schemas/2015/feed">
+  <channel>
+    <title>Nyaa</title>
+    <torznab:response offset="0" total="1" />
+    <item>
+      <title>Big Buck Bunny 2008 1080p</title>
+      <guid>https://example.org/view/1</guid>
+      <link>http://localhost:9696/3/download?apikey=x&amp;link=abc</link>
+      <comments>https://example.org/view/1</comments>
+      <pubDate>Sat, 11 Apr 2026 10:00:00 +0000</pubDate>
+      <size>1073741824</size>
+      <description />
+      <enclosure url="http://localhost:9696/3/download?apikey=x&amp;link=abc" length="1073741824" type="application/x-bittorrent" />
+      <torznab:attr name="category" value="2000" />
+      <torznab:attr name="category" value="2040" />
+      <torznab:attr name="seeders" value="12" />
+      <torznab:attr name="infohash" value="ABCDEF" />
+    </item>
+  </channel>
+</rss>`
+
+func TestGetNewznabCaps(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "3", "newznab") + "?t=caps",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   newznabCapsBody,
+			WithResponse: &prowlarr.NewznabCaps{
+				Server:   prowlarr.NewznabServer{Title: "Prowlarr"},
+				MaxLimit: 100,
+				Limit:    100,
+				Searching: map[prowlarr.NewznabMode]*prowlarr.NewznabSearching{
+					prowlarr.NewznabModeSearch: {Available: true, SupportedParams: []string{"q"}},
+					prowlarr.NewznabModeTV: {
+						Available: true, SupportedParams: []string{"q", "season", "ep", "imdbid", "tvdbid"},
+					},
+					prowlarr.NewznabModeMovie: {Available: true, SupportedParams: []string{"q", "imdbid", "tmdbid"}},
+					prowlarr.NewznabModeMusic: {Available: false},
+					prowlarr.NewznabModeBook:  {Available: true, SupportedParams: []string{"q", "author", "title"}},
+				},
+				Categories: []*prowlarr.Category{
+					{ID: 2000, Name: "Movies", SubCategories: []*prowlarr.Category{{ID: 2040, Name: "Movies/HD"}}},
+					{ID: 5000, Name: "TV"},
+				},
+			},
+		},
+		{
+			Name:           "newznab error",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "3", "newznab") + "?t=caps",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `<?xml version="1.0" encoding="UTF-8"?><error code="100" description="Invalid API Key" />`,
+			WithError:      prowlarr.ErrNewznab,
+			WithResponse:   (*prowlarr.NewznabCaps)(nil),
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "3", "newznab") + "?t=caps",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*prowlarr.NewznabCaps)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetNewznabCaps(3)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestNewznabSearch(t *testing.T) {
+	t.Parallel()
+
+	results := &prowlarr.NewznabResults{
+		Total: 1,
+		Items: []*prowlarr.NewznabItem{{
+			Title:       "Big Buck Bunny 2008 1080p",
+			GUID:        "https://example.org/view/1",
+			Link:        "http://localhost:9696/3/download?apikey=x&link=abc",
+			Comments:    "https://example.org/view/1",
+			PublishDate: time.Date(2026, 4, 11, 10, 0, 0, 0, time.UTC),
+			Size:        1073741824,
+			Categories:  []*prowlarr.Category{{ID: 2000}, {ID: 2040}},
+			Enclosure: prowlarr.NewznabEnclosure{
+				URL:    "http://localhost:9696/3/download?apikey=x&link=abc",
+				Length: 1073741824,
+				Type:   "application/x-bittorrent",
+			},
+			Attributes: map[string]string{"seeders": "12", "infohash": "ABCDEF"},
+		}},
+	}
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "3", "newznab") + "?q=bunny&t=search",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   newznabSearchBody,
+			WithRequest:    &prowlarr.NewznabQuery{Query: "bunny"},
+			WithResponse:   results,
+		},
+		{
+			Name: "200 movie",
+			ExpectedPath: path.Join("/", starr.API, prowlarr.APIver, "indexer", "3", "newznab") +
+				"?cat=2000%2C2040&extended=1&imdbid=tt1254207&limit=50&t=movie&year=2008",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   newznabSearchBody,
+			WithRequest: &prowlarr.NewznabQuery{
+				Mode:       prowlarr.NewznabModeMovie,
+				IMDbID:     "tt1254207",
+				Year:       2008,
+				Categories: []int64{2000, 2040},
+				Limit:      50,
+				Extended:   true,
+			},
+			WithResponse: results,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "indexer", "3", "newznab") + "?t=search",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    (*prowlarr.NewznabQuery)(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*prowlarr.NewznabResults)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.NewznabSearch(3, test.WithRequest.(*prowlarr.NewznabQuery))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
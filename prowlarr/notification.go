@@ -61,6 +61,35 @@ type NotificationOutput struct {
 	} `json:"message"`
 }
 
+// ToInput converts the output of a notification into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (n *NotificationOutput) ToInput() *NotificationInput {
+	if n == nil {
+		return nil
+	}
+
+	return &NotificationInput{
+		OnGrab:                      n.OnGrab,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnHealthRestored:            n.OnHealthRestored,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		SupportsOnGrab:              n.SupportsOnGrab,
+		IncludeManualGrabs:          n.IncludeManualGrabs,
+		SupportsOnHealthIssue:       n.SupportsOnHealthIssue,
+		SupportsOnHealthRestored:    n.SupportsOnHealthRestored,
+		IncludeHealthWarnings:       n.IncludeHealthWarnings,
+		SupportsOnApplicationUpdate: n.SupportsOnApplicationUpdate,
+		ID:                          n.ID,
+		Name:                        n.Name,
+		ImplementationName:          n.ImplementationName,
+		Implementation:              n.Implementation,
+		ConfigContract:              n.ConfigContract,
+		InfoLink:                    n.InfoLink,
+		Tags:                        n.Tags,
+		Fields:                      starr.ToFieldInputs(n.Fields),
+	}
+}
+
 // GetNotifications returns all configured notifications.
 func (p *Prowlarr) GetNotifications() ([]*NotificationOutput, error) {
 	return p.GetNotificationsContext(context.Background())
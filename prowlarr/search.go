@@ -11,7 +11,10 @@ import (
 	"golift.io/starr"
 )
 
-const bpSearch = APIver + "/search"
+const (
+	bpSearch     = APIver + "/search"
+	bpSearchBulk = bpSearch + "/bulk"
+)
 
 // Search is the output from the Prowlarr search endpoint.
 type Search struct {
@@ -141,3 +144,37 @@ func (p *Prowlarr) GrabSearchContext(ctx context.Context, search *Search) (*Sear
 
 	return &output, nil
 }
+
+// GrabSearches attempts to download several items returned from searches.
+// Pass the searches for the items from the Search() output.
+func (p *Prowlarr) GrabSearches(searches []*Search) ([]*Search, error) {
+	return p.GrabSearchesContext(context.Background(), searches)
+}
+
+// GrabSearchesContext attempts to download several items returned from searches.
+// Pass the searches for the items from the Search() output.
+func (p *Prowlarr) GrabSearchesContext(ctx context.Context, searches []*Search) ([]*Search, error) {
+	type grab struct { // We only use/need the guid and indexerID from the searches.
+		G string `json:"guid"`
+		I int64  `json:"indexerId"`
+	}
+
+	grabs := make([]grab, len(searches))
+	for idx, search := range searches {
+		grabs[idx] = grab{G: search.GUID, I: search.IndexerID}
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(grabs); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpSearchBulk, err)
+	}
+
+	var output []*Search
+
+	req := starr.Request{URI: bpSearchBulk, Body: &body}
+	if err := p.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,62 @@
+package prowlarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGrabSearches(t *testing.T) {
+	t.Parallel()
+
+	searches := []*prowlarr.Search{
+		{GUID: "https://example.org/view/1", IndexerID: 3, Title: "ignored"},
+		{GUID: "https://example.org/view/2", IndexerID: 4},
+	}
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, prowlarr.APIver, "search", "bulk"),
+			ExpectedMethod: http.MethodPost,
+			ExpectedRequest: `[{"guid":"https://example.org/view/1","indexerId":3},` +
+				`{"guid":"https://example.org/view/2","indexerId":4}]` + "\n",
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"guid": "https://example.org/view/1", "indexerId": 3, "title": "Big Buck Bunny"},
+				{"guid": "https://example.org/view/2", "indexerId": 4, "title": "Sintel"}]`,
+			WithRequest: searches,
+			WithResponse: []*prowlarr.Search{
+				{GUID: "https://example.org/view/1", IndexerID: 3, Title: "Big Buck Bunny"},
+				{GUID: "https://example.org/view/2", IndexerID: 4, Title: "Sintel"},
+			},
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, prowlarr.APIver, "search", "bulk"),
+			ExpectedMethod:  http.MethodPost,
+			ExpectedRequest: `[]` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithRequest:     []*prowlarr.Search{},
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    ([]*prowlarr.Search)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := prowlarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GrabSearches(test.WithRequest.([]*prowlarr.Search))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package radarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const (
+	bpConfigHost       = APIver + "/config/host"
+	bpConfigUI         = APIver + "/config/ui"
+	bpConfigImportList = APIver + "/config/importlist"
+)
+
+// HostConfig is the /api/v3/config/host resource.
+type HostConfig = starrshared.HostConfig
+
+// UIConfig is the /api/v3/config/ui resource.
+type UIConfig struct {
+	ID                       int    `json:"id,omitempty"`
+	FirstDayOfWeek           int    `json:"firstDayOfWeek"`
+	CalendarWeekColumnHeader string `json:"calendarWeekColumnHeader,omitempty"`
+	MovieRuntimeFormat       string `json:"movieRuntimeFormat,omitempty"`
+	ShortDateFormat          string `json:"shortDateFormat,omitempty"`
+	LongDateFormat           string `json:"longDateFormat,omitempty"`
+	TimeFormat               string `json:"timeFormat,omitempty"`
+	ShowRelativeDates        bool   `json:"showRelativeDates"`
+	EnableColorImpairedMode  bool   `json:"enableColorImpairedMode"`
+	MovieInfoLanguage        int    `json:"movieInfoLanguage"`
+	UILanguage               int    `json:"uiLanguage"`
+	Theme                    string `json:"theme,omitempty"`
+}
+
+// ImportListConfig is the /api/v3/config/importlist resource.
+// ListSyncLevel is what happens to movies removed from every list:
+// disabled, logOnly, keepAndUnmonitor, removeAndKeep or removeAndDelete.
+type ImportListConfig struct {
+	ID            int    `json:"id,omitempty"`
+	ListSyncLevel string `json:"listSyncLevel,omitempty"`
+}
+
+// GetHostConfig returns the host configuration.
+func (r *Radarr) GetHostConfig() (*HostConfig, error) {
+	return r.GetHostConfigContext(context.Background())
+}
+
+// GetHostConfigContext returns the host configuration.
+func (r *Radarr) GetHostConfigContext(ctx context.Context) (*HostConfig, error) {
+	var output HostConfig
+
+	req := starr.Request{URI: bpConfigHost}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetHostConfigByID returns the host configuration for the given id.
+func (r *Radarr) GetHostConfigByID(id int) (*HostConfig, error) {
+	return r.GetHostConfigByIDContext(context.Background(), id)
+}
+
+// GetHostConfigByIDContext returns the host configuration for the given id.
+func (r *Radarr) GetHostConfigByIDContext(ctx context.Context, id int) (*HostConfig, error) {
+	var output HostConfig
+
+	req := starr.Request{URI: path.Join(bpConfigHost, starr.Str(id))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateHostConfig updates the host configuration.
+func (r *Radarr) UpdateHostConfig(input *HostConfig) (*HostConfig, error) {
+	return r.UpdateHostConfigContext(context.Background(), input)
+}
+
+// UpdateHostConfigContext updates the host configuration.
+func (r *Radarr) UpdateHostConfigContext(ctx context.Context, input *HostConfig) (*HostConfig, error) {
+	var output HostConfig
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpConfigHost, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpConfigHost, starr.Str(input.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetUIConfig returns the UI configuration.
+func (r *Radarr) GetUIConfig() (*UIConfig, error) {
+	return r.GetUIConfigContext(context.Background())
+}
+
+// GetUIConfigContext returns the UI configuration.
+func (r *Radarr) GetUIConfigContext(ctx context.Context) (*UIConfig, error) {
+	var output UIConfig
+
+	req := starr.Request{URI: bpConfigUI}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetUIConfigByID returns the UI configuration for the given id.
+func (r *Radarr) GetUIConfigByID(id int) (*UIConfig, error) {
+	return r.GetUIConfigByIDContext(context.Background(), id)
+}
+
+// GetUIConfigByIDContext returns the UI configuration for the given id.
+func (r *Radarr) GetUIConfigByIDContext(ctx context.Context, id int) (*UIConfig, error) {
+	var output UIConfig
+
+	req := starr.Request{URI: path.Join(bpConfigUI, starr.Str(id))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateUIConfig updates the UI configuration.
+func (r *Radarr) UpdateUIConfig(input *UIConfig) (*UIConfig, error) {
+	return r.UpdateUIConfigContext(context.Background(), input)
+}
+
+// UpdateUIConfigContext updates the UI configuration.
+func (r *Radarr) UpdateUIConfigContext(ctx context.Context, input *UIConfig) (*UIConfig, error) {
+	var output UIConfig
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpConfigUI, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpConfigUI, starr.Str(input.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetImportListConfig returns the import list global configuration.
+func (r *Radarr) GetImportListConfig() (*ImportListConfig, error) {
+	return r.GetImportListConfigContext(context.Background())
+}
+
+// GetImportListConfigContext returns the import list global configuration.
+func (r *Radarr) GetImportListConfigContext(ctx context.Context) (*ImportListConfig, error) {
+	var output ImportListConfig
+
+	req := starr.Request{URI: bpConfigImportList}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetImportListConfigByID returns the import list global configuration for the given id.
+func (r *Radarr) GetImportListConfigByID(id int) (*ImportListConfig, error) {
+	return r.GetImportListConfigByIDContext(context.Background(), id)
+}
+
+// GetImportListConfigByIDContext returns the import list global configuration for the given id.
+func (r *Radarr) GetImportListConfigByIDContext(ctx context.Context, id int) (*ImportListConfig, error) {
+	var output ImportListConfig
+
+	req := starr.Request{URI: path.Join(bpConfigImportList, starr.Str(id))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateImportListConfig updates the import list global configuration.
+func (r *Radarr) UpdateImportListConfig(input *ImportListConfig) (*ImportListConfig, error) {
+	return r.UpdateImportListConfigContext(context.Background(), input)
+}
+
+// UpdateImportListConfigContext updates the import list global configuration.
+func (r *Radarr) UpdateImportListConfigContext(
+	ctx context.Context, input *ImportListConfig,
+) (*ImportListConfig, error) {
+	var output ImportListConfig
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpConfigImportList, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpConfigImportList, starr.Str(input.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,87 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetImportListConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "config", "importlist"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"id":1,"listSyncLevel":"logOnly"}`,
+			WithResponse:   &radarr.ImportListConfig{ID: 1, ListSyncLevel: "logOnly"},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "config", "importlist"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*radarr.ImportListConfig)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetImportListConfig()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestUpdateImportListConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "config", "importlist", "1"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"id":1,"listSyncLevel":"keepAndUnmonitor"}` + "\n",
+			ResponseStatus:  http.StatusAccepted,
+			ResponseBody:    `{"id":1,"listSyncLevel":"keepAndUnmonitor"}`,
+			WithRequest:     &radarr.ImportListConfig{ID: 1, ListSyncLevel: "keepAndUnmonitor"},
+			WithResponse:    &radarr.ImportListConfig{ID: 1, ListSyncLevel: "keepAndUnmonitor"},
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "config", "importlist", "1"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"id":1,"listSyncLevel":"keepAndUnmonitor"}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithRequest:     &radarr.ImportListConfig{ID: 1, ListSyncLevel: "keepAndUnmonitor"},
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    (*radarr.ImportListConfig)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.UpdateImportListConfig(test.WithRequest.(*radarr.ImportListConfig))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -502,3 +502,42 @@ func TestDeleteDownloadClient(t *testing.T) {
 		})
 	}
 }
+
+const importListResponseBody = `{
+    "enableAuto": true,
+    "enabled": true,
+    "searchOnAdd": true,
+    "listOrder": 1,
+    "id": 3,
+    "qualityProfileId": 4,
+    "configContract": "TraktListSettings",
+    "implementation": "TraktListImport",
+    "implementationName": "Trakt List",
+    "infoLink": "https://wiki.servarr.com/radarr/supported#traktlistimport",
+    "monitor": "movieOnly",
+    "listType": "trakt",
+    "name": "Watchlist",
+    "rootFolderPath": "/movies",
+    "minimumAvailability": "released",
+    "tags": [1],
+    "fields": [{"order": 0, "name": "username", "label": "Username", "value": "me", "type": "textbox"}]
+}`
+
+func TestOutputToInput(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(*testing.T){
+		"CustomFormat":   starrtest.ToInputCase((*radarr.CustomFormatOutput).ToInput, customFormatResponseBody),
+		"DownloadClient": starrtest.ToInputCase((*radarr.DownloadClientOutput).ToInput, downloadClientResponseBody),
+		"ImportList":     starrtest.ToInputCase((*radarr.ImportListOutput).ToInput, importListResponseBody),
+		"Indexer":        starrtest.ToInputCase((*radarr.IndexerOutput).ToInput, indexerResponseBody),
+		"Notification":   starrtest.ToInputCase((*radarr.NotificationOutput).ToInput, notificationResponseBody),
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			test(t)
+		})
+	}
+}
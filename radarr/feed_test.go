@@ -0,0 +1,72 @@
+package radarr_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+const feedBody = "BEGIN:VCALENDAR\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:Radarr_cinemaRelease_7\r\n" +
+	"DTSTART:20260412T010000Z\r\n" +
+	"DTEND:20260412T020000Z\r\n" +
+	"SUMMARY:The Movie (2026) - Cinema Release\r\n" +
+	"CATEGORIES:Action\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestGetFeedEvents(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: "/feed/v3/calendar/radarr.ics?asAllDay=true&futureDays=14&pastDays=1" +
+				"&releaseTypes=cinemaRelease%2CdigitalRelease&tags=1%2C2&unmonitored=false",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   feedBody,
+			WithResponse: []*radarr.FeedEvent{{
+				UID:        "Radarr_cinemaRelease_7",
+				Start:      time.Date(2026, 4, 12, 1, 0, 0, 0, time.UTC),
+				End:        time.Date(2026, 4, 12, 2, 0, 0, 0, time.UTC),
+				Summary:    "The Movie (2026) - Cinema Release",
+				Categories: []string{"Action"},
+			}},
+		},
+		{
+			Name: "404",
+			ExpectedPath: "/feed/v3/calendar/radarr.ics?asAllDay=true&futureDays=14&pastDays=1" +
+				"&releaseTypes=cinemaRelease%2CdigitalRelease&tags=1%2C2&unmonitored=false",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*radarr.FeedEvent)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetFeedEvents(radarr.Feed{
+				PastDays:     1,
+				FutureDays:   14,
+				Tags:         []int{1, 2},
+				AsAllDay:     true,
+				ReleaseTypes: []radarr.ReleaseType{radarr.ReleaseTypeCinema, radarr.ReleaseTypeDigital},
+			})
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestBrowseFilesystem(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "filesystem") +
+				"?allowFoldersWithoutTrailingSlashes=false&includeFiles=true&path=%2Fmovies%2F",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"name":"Up (2009)","path":"/movies/Up (2009)/"}]`,
+			WithRequest:    &radarr.FilesystemQuery{Path: "/movies/", IncludeFiles: true},
+			WithResponse:   []*starr.Path{{Name: "Up (2009)", Path: "/movies/Up (2009)/"}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "filesystem"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    (*radarr.FilesystemQuery)(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*starr.Path(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.BrowseFilesystem(test.WithRequest.(*radarr.FilesystemQuery))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestBrowseFilesystemMediaFiles(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "filesystem", "mediafiles") + "?path=%2Fdownloads",
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `[{"path":"/downloads/Up.2009.mkv","relativePath":"Up.2009.mkv","name":"Up.2009.mkv"}]`,
+		WithResponse:   []*starr.Path{{Name: "Up.2009.mkv", Path: "/downloads/Up.2009.mkv"}},
+	}
+
+	client := radarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	output, err := client.BrowseFilesystemMediaFiles("/downloads")
+	require.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+}
+
+func TestGetFilesystemType(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "filesystem", "type") + "?path=%2Fmovies",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"type":"folder"}`,
+			WithResponse:   []byte(`{"type":"folder"}`),
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "filesystem", "type") + "?path=%2Fmovies",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []byte(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetFilesystemType("/movies")
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.Equal(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
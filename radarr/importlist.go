@@ -55,6 +55,34 @@ type ImportListOutput struct {
 	Fields              []*starr.FieldOutput `json:"fields"`
 }
 
+// ToInput converts the output of an import list into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (l *ImportListOutput) ToInput() *ImportListInput {
+	if l == nil {
+		return nil
+	}
+
+	return &ImportListInput{
+		EnableAuto:          l.EnableAuto,
+		Enabled:             l.Enabled,
+		SearchOnAdd:         l.SearchOnAdd,
+		ListOrder:           int(l.ListOrder), //nolint:gosec // small number.
+		ID:                  l.ID,
+		QualityProfileID:    l.QualityProfileID,
+		ConfigContract:      l.ConfigContract,
+		Implementation:      l.Implementation,
+		ImplementationName:  l.ImplementationName,
+		InfoLink:            l.InfoLink,
+		ListType:            l.ListType,
+		Monitor:             l.Monitor,
+		Name:                l.Name,
+		RootFolderPath:      l.RootFolderPath,
+		MinimumAvailability: l.MinimumAvailability,
+		Tags:                l.Tags,
+		Fields:              starr.ToFieldInputs(l.Fields),
+	}
+}
+
 // GetImportLists returns all import lists.
 func (r *Radarr) GetImportLists() ([]*ImportListOutput, error) {
 	return r.GetImportListsContext(context.Background())
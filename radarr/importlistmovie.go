@@ -0,0 +1,125 @@
+package radarr
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+
+	"golift.io/starr"
+)
+
+// ImportListMovie is a movie from the /api/v3/importlist/movie endpoint.
+// Lists has the IDs of the import lists that include the movie.
+type ImportListMovie struct {
+	Movie
+
+	Lists            []int64 `json:"lists,omitempty"`
+	IsExcluded       bool    `json:"isExcluded"`
+	IsExisting       bool    `json:"isExisting"`
+	IsRecommendation bool    `json:"isRecommendation"`
+}
+
+// GetImportListMovies adds discovery movies to the movies pending from import lists.
+type GetImportListMovies struct {
+	IncludeRecommendations bool
+	IncludeTrending        bool
+	IncludePopular         bool
+}
+
+// ImportListPreview sorts the movies from one import list by what the list would do with them.
+type ImportListPreview struct {
+	ListID int64
+	// Add are the movies the list would add: not in the library, and not excluded.
+	Add []*ImportListMovie
+	// Existing are the movies already in the library.
+	Existing []*ImportListMovie
+	// Excluded are the movies on the import list exclusion list.
+	Excluded []*ImportListMovie
+}
+
+// GetImportListMovies returns the movies pending from all import lists.
+// Radarr fetches every enabled list when lists sync. Turn off a list's EnableAuto
+// to fetch its movies without adding them. Options may be nil.
+func (r *Radarr) GetImportListMovies(opts *GetImportListMovies) ([]*ImportListMovie, error) {
+	return r.GetImportListMoviesContext(context.Background(), opts)
+}
+
+// GetImportListMoviesContext returns the movies pending from all import lists.
+func (r *Radarr) GetImportListMoviesContext(
+	ctx context.Context, opts *GetImportListMovies,
+) ([]*ImportListMovie, error) {
+	if opts == nil {
+		opts = &GetImportListMovies{}
+	}
+
+	params := make(url.Values)
+	params.Set("includeRecommendations", starr.Str(opts.IncludeRecommendations))
+	params.Set("includeTrending", starr.Str(opts.IncludeTrending))
+	params.Set("includePopular", starr.Str(opts.IncludePopular))
+
+	var output []*ImportListMovie
+
+	req := starr.Request{URI: path.Join(bpImportList, "movie"), Query: params}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// PreviewImportLists compares the movies pending from every import list with the movies in the library.
+// The previews are sorted by list ID. Lists without pending movies are not included.
+func (r *Radarr) PreviewImportLists() ([]*ImportListPreview, error) {
+	return r.PreviewImportListsContext(context.Background())
+}
+
+// PreviewImportListsContext compares the movies pending from every import list with the movies in the library.
+func (r *Radarr) PreviewImportListsContext(ctx context.Context) ([]*ImportListPreview, error) {
+	movies, err := r.GetImportListMoviesContext(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	library, err := r.GetMovieContext(ctx, &GetMovie{ExcludeLocalCovers: true})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[int64]bool, len(library))
+	for _, movie := range library {
+		existing[movie.TmdbID] = true
+	}
+
+	previews := make(map[int64]*ImportListPreview)
+
+	for _, movie := range movies {
+		for _, listID := range movie.Lists {
+			if previews[listID] == nil {
+				previews[listID] = &ImportListPreview{ListID: listID}
+			}
+
+			preview := previews[listID]
+
+			switch {
+			case movie.IsExisting || existing[movie.TmdbID]:
+				preview.Existing = append(preview.Existing, movie)
+			case movie.IsExcluded:
+				preview.Excluded = append(preview.Excluded, movie)
+			default:
+				preview.Add = append(preview.Add, movie)
+			}
+		}
+	}
+
+	output := make([]*ImportListPreview, 0, len(previews))
+	for _, preview := range previews {
+		output = append(output, preview)
+	}
+
+	slices.SortFunc(output, func(a, b *ImportListPreview) int { return cmp.Compare(a.ListID, b.ListID) })
+
+	return output, nil
+}
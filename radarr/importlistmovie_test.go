@@ -0,0 +1,101 @@
+package radarr_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+const importListMoviesBody = `[
+	{"title": "New", "tmdbId": 1, "lists": [1, 2], "isExcluded": false, "isExisting": false},
+	{"title": "Owned", "tmdbId": 2, "lists": [1], "isExcluded": false, "isExisting": true},
+	{"title": "Added Since Sync", "tmdbId": 3, "lists": [2], "isExcluded": false, "isExisting": false},
+	{"title": "Excluded", "tmdbId": 4, "lists": [2], "isExcluded": true, "isExisting": false}
+]`
+
+func TestGetImportListMovies(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: "/api/v3/importlist/movie?includePopular=false&includeRecommendations=true" +
+				"&includeTrending=false",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			WithRequest:    &radarr.GetImportListMovies{IncludeRecommendations: true},
+			ResponseBody:   `[{"title": "New", "tmdbId": 1, "lists": [], "isRecommendation": true}]`,
+			WithResponse: []*radarr.ImportListMovie{
+				{Movie: radarr.Movie{Title: "New", TmdbID: 1}, Lists: []int64{}, IsRecommendation: true},
+			},
+		},
+		{
+			Name: "404",
+			ExpectedPath: "/api/v3/importlist/movie?includePopular=false&includeRecommendations=false" +
+				"&includeTrending=false",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*radarr.ImportListMovie)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			opts, _ := test.WithRequest.(*radarr.GetImportListMovies)
+			output, err := client.GetImportListMovies(opts)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestPreviewImportLists(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v3/importlist/movie", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(importListMoviesBody))
+	})
+	mux.HandleFunc("GET /api/v3/movie", func(w http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "true", req.URL.Query().Get("excludeLocalCovers"))
+		_, _ = w.Write([]byte(`[{"id": 10, "title": "Owned", "tmdbId": 2}, {"id": 11, "tmdbId": 3}]`))
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client := radarr.New(starr.New("mockAPIkey", server.URL, 0))
+	previews, err := client.PreviewImportLists()
+	require.NoError(t, err)
+	require.Len(t, previews, 2)
+
+	titles := func(movies []*radarr.ImportListMovie) []string {
+		list := []string{}
+		for _, movie := range movies {
+			list = append(list, movie.Title)
+		}
+
+		return list
+	}
+
+	assert.Equal(t, int64(1), previews[0].ListID)
+	assert.Equal(t, []string{"New"}, titles(previews[0].Add))
+	assert.Equal(t, []string{"Owned"}, titles(previews[0].Existing))
+	assert.Empty(t, previews[0].Excluded)
+
+	assert.Equal(t, int64(2), previews[1].ListID)
+	assert.Equal(t, []string{"New"}, titles(previews[1].Add))
+	assert.Equal(t, []string{"Added Since Sync"}, titles(previews[1].Existing), "the library must be checked too")
+	assert.Equal(t, []string{"Excluded"}, titles(previews[1].Excluded))
+}
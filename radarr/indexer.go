@@ -48,6 +48,29 @@ type IndexerOutput struct {
 	Fields                  []*starr.FieldOutput `json:"fields"`
 }
 
+// ToInput converts the output of an indexer into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (i *IndexerOutput) ToInput() *IndexerInput {
+	if i == nil {
+		return nil
+	}
+
+	return &IndexerInput{
+		EnableAutomaticSearch:   i.EnableAutomaticSearch,
+		EnableInteractiveSearch: i.EnableInteractiveSearch,
+		EnableRss:               i.EnableRss,
+		DownloadClientID:        i.DownloadClientID,
+		Priority:                i.Priority,
+		ID:                      i.ID,
+		ConfigContract:          i.ConfigContract,
+		Implementation:          i.Implementation,
+		Name:                    i.Name,
+		Protocol:                i.Protocol,
+		Tags:                    i.Tags,
+		Fields:                  starr.ToFieldInputs(i.Fields),
+	}
+}
+
 // GetIndexers returns all configured indexers.
 func (r *Radarr) GetIndexers() ([]*IndexerOutput, error) {
 	return r.GetIndexersContext(context.Background())
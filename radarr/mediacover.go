@@ -0,0 +1,41 @@
+package radarr
+
+import (
+	"context"
+	"net/url"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpMediaCover = APIver + "/mediacover"
+
+// MediaCover is an open media cover image download.
+type MediaCover = starrshared.MediaCover
+
+// GetMediaCover downloads a movie media cover image (jpg, png, or gif).
+// The filename is from the image URL, like poster.jpg or fanart-500.jpg.
+func (r *Radarr) GetMediaCover(movieID int64, filename string) ([]byte, error) {
+	return r.GetMediaCoverContext(context.Background(), movieID, filename)
+}
+
+// GetMediaCoverContext downloads a movie media cover image (jpg, png, or gif).
+// The filename is from the image URL, like poster.jpg or fanart-500.jpg.
+func (r *Radarr) GetMediaCoverContext(ctx context.Context, movieID int64, filename string) ([]byte, error) {
+	uri := path.Join(bpMediaCover, starr.Str(movieID), url.PathEscape(filename))
+	return starrshared.ReadMediaCover(ctx, r.APIer, uri)
+}
+
+// OpenMediaCover opens a movie media cover image; pass a previous ETag to skip an unchanged image.
+func (r *Radarr) OpenMediaCover(movieID int64, filename, etag string) (*MediaCover, error) {
+	return r.OpenMediaCoverContext(context.Background(), movieID, filename, etag)
+}
+
+// OpenMediaCoverContext opens a movie media cover image; pass a previous ETag to skip an unchanged image.
+func (r *Radarr) OpenMediaCoverContext(
+	ctx context.Context, movieID int64, filename, etag string,
+) (*MediaCover, error) {
+	uri := path.Join(bpMediaCover, starr.Str(movieID), url.PathEscape(filename))
+	return starrshared.OpenMediaCover(ctx, r.APIer, uri, etag)
+}
@@ -0,0 +1,60 @@
+package radarr_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+)
+
+func TestOpenMediaCover(t *testing.T) {
+	t.Parallel()
+
+	const etag = `"8dc1a2b3"`
+
+	modified := time.Date(2026, 4, 12, 10, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/api/v3/mediacover/7/poster%20500.jpg", req.URL.EscapedPath())
+
+		if req.Header.Get("If-None-Match") == etag {
+			writer.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		writer.Header().Set("Content-Type", "image/jpeg")
+		writer.Header().Set("ETag", etag)
+		writer.Header().Set("Last-Modified", modified.Format(http.TimeFormat))
+		_, _ = writer.Write([]byte("jpeg data"))
+	}))
+	t.Cleanup(server.Close)
+
+	client := radarr.New(starr.New("mockAPIkey", server.URL, 0))
+
+	cover, err := client.OpenMediaCover(7, "poster 500.jpg", "")
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(cover)
+	require.NoError(t, err)
+	require.NoError(t, cover.Close())
+	assert.Equal(t, "jpeg data", string(body))
+	assert.Equal(t, "image/jpeg", cover.ContentType)
+	assert.Equal(t, etag, cover.ETag)
+	assert.Equal(t, modified, cover.LastModified.UTC())
+	assert.False(t, cover.NotModified)
+
+	cover, err = client.OpenMediaCover(7, "poster 500.jpg", etag)
+	require.NoError(t, err, "a 304 must not be an error")
+	assert.True(t, cover.NotModified)
+	assert.Equal(t, etag, cover.ETag)
+	require.NoError(t, cover.Close())
+
+	body, err = client.GetMediaCover(7, "poster 500.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, "jpeg data", string(body))
+}
@@ -0,0 +1,206 @@
+package radarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpMetadata = APIver + "/metadata"
+
+// MetadataProviderMessage is the provider message object on metadata consumers.
+type MetadataProviderMessage = starrshared.MetadataProviderMessage
+
+// MetadataOutput is the output from /api/v3/metadata (MetadataResource).
+type MetadataOutput = starrshared.MetadataOutput
+
+// MetadataInput is the input for creating or updating metadata consumers.
+type MetadataInput = starrshared.MetadataInput
+
+// GetMetadata returns all configured metadata consumers.
+func (r *Radarr) GetMetadata() ([]*MetadataOutput, error) {
+	return r.GetMetadataContext(context.Background())
+}
+
+// GetMetadataContext returns all configured metadata consumers.
+func (r *Radarr) GetMetadataContext(ctx context.Context) ([]*MetadataOutput, error) {
+	var output []*MetadataOutput
+
+	req := starr.Request{URI: bpMetadata}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetMetadataByID returns a single metadata consumer.
+func (r *Radarr) GetMetadataByID(id int64) (*MetadataOutput, error) {
+	return r.GetMetadataByIDContext(context.Background(), id)
+}
+
+// GetMetadataByIDContext returns a single metadata consumer.
+func (r *Radarr) GetMetadataByIDContext(ctx context.Context, id int64) (*MetadataOutput, error) {
+	var output MetadataOutput
+
+	req := starr.Request{URI: path.Join(bpMetadata, starr.Str(id))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetMetadataSchema returns metadata consumer templates.
+func (r *Radarr) GetMetadataSchema() ([]*MetadataOutput, error) {
+	return r.GetMetadataSchemaContext(context.Background())
+}
+
+// GetMetadataSchemaContext returns metadata consumer templates.
+func (r *Radarr) GetMetadataSchemaContext(ctx context.Context) ([]*MetadataOutput, error) {
+	var output []*MetadataOutput
+
+	req := starr.Request{URI: path.Join(bpMetadata, "schema")}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// AddMetadata creates a metadata consumer.
+func (r *Radarr) AddMetadata(input *MetadataInput, forceSave bool) (*MetadataOutput, error) {
+	return r.AddMetadataContext(context.Background(), input, forceSave)
+}
+
+// AddMetadataContext creates a metadata consumer.
+func (r *Radarr) AddMetadataContext(
+	ctx context.Context, input *MetadataInput, forceSave bool,
+) (*MetadataOutput, error) {
+	var output MetadataOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMetadata, err)
+	}
+
+	req := starr.Request{URI: bpMetadata, Body: &body, Query: starr.ForceSave(forceSave)}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateMetadata updates a metadata consumer.
+func (r *Radarr) UpdateMetadata(input *MetadataInput, forceSave bool) (*MetadataOutput, error) {
+	return r.UpdateMetadataContext(context.Background(), input, forceSave)
+}
+
+// UpdateMetadataContext updates a metadata consumer.
+func (r *Radarr) UpdateMetadataContext(
+	ctx context.Context, input *MetadataInput, forceSave bool,
+) (*MetadataOutput, error) {
+	var output MetadataOutput
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMetadata, err)
+	}
+
+	uri := path.Join(bpMetadata, starr.Str(input.ID))
+
+	req := starr.Request{URI: uri, Body: &body, Query: starr.ForceSave(forceSave)}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteMetadata deletes a metadata consumer.
+func (r *Radarr) DeleteMetadata(id int64) error {
+	return r.DeleteMetadataContext(context.Background(), id)
+}
+
+// DeleteMetadataContext deletes a metadata consumer.
+func (r *Radarr) DeleteMetadataContext(ctx context.Context, id int64) error {
+	req := starr.Request{URI: path.Join(bpMetadata, starr.Str(id))}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// MetadataAction runs a named action on a metadata consumer.
+func (r *Radarr) MetadataAction(name string, input *MetadataInput) error {
+	return r.MetadataActionContext(context.Background(), name, input)
+}
+
+// MetadataActionContext runs a named action on a metadata consumer.
+func (r *Radarr) MetadataActionContext(ctx context.Context, name string, input *MetadataInput) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpMetadata, err)
+	}
+
+	var output any
+
+	req := starr.Request{URI: path.Join(bpMetadata, "action", path.Base(name)), Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// TestMetadata tests a metadata consumer configuration.
+func (r *Radarr) TestMetadata(input *MetadataInput, forceTest bool) error {
+	return r.TestMetadataContext(context.Background(), input, forceTest)
+}
+
+// TestMetadataContext tests a metadata consumer configuration.
+func (r *Radarr) TestMetadataContext(ctx context.Context, input *MetadataInput, forceTest bool) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpMetadata, err)
+	}
+
+	query := url.Values{}
+	if forceTest {
+		query.Set("forceTest", "true")
+	}
+
+	var output any
+
+	req := starr.Request{URI: path.Join(bpMetadata, "test"), Body: &body, Query: query}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// TestAllMetadata tests all metadata consumers.
+func (r *Radarr) TestAllMetadata() error {
+	return r.TestAllMetadataContext(context.Background())
+}
+
+// TestAllMetadataContext tests all metadata consumers.
+func (r *Radarr) TestAllMetadataContext(ctx context.Context) error {
+	var output any
+
+	req := starr.Request{URI: path.Join(bpMetadata, "testall")}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
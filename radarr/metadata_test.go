@@ -0,0 +1,249 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+const metadataBody = `{
+	"enable": true,
+	"name": "Kodi (XBMC) / Emby",
+	"fields": [{"order": 0, "name": "movieMetadata", "label": "Movie Metadata", "value": true, "type": "checkbox"}],
+	"implementationName": "Kodi (XBMC) / Emby",
+	"implementation": "XbmcMetadata",
+	"configContract": "XbmcMetadataSettings",
+	"infoLink": "https://wiki.servarr.com/radarr/supported#xbmcmetadata",
+	"tags": [1],
+	"id": 1
+}`
+
+var metadataOutput = &radarr.MetadataOutput{
+	ID:     1,
+	Enable: true,
+	Name:   "Kodi (XBMC) / Emby",
+	Fields: []*starr.FieldOutput{
+		{Order: 0, Name: "movieMetadata", Label: "Movie Metadata", Value: true, Type: "checkbox"},
+	},
+	ImplementationName: "Kodi (XBMC) / Emby",
+	Implementation:     "XbmcMetadata",
+	ConfigContract:     "XbmcMetadataSettings",
+	InfoLink:           "https://wiki.servarr.com/radarr/supported#xbmcmetadata",
+	Tags:               []int{1},
+}
+
+func TestGetMetadata(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "metadata"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody:   "[" + metadataBody + "]",
+			WithResponse:   []*radarr.MetadataOutput{metadataOutput},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "metadata"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*radarr.MetadataOutput(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMetadata()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetMetadataByID(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "metadata", "1"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    int64(1),
+			ResponseBody:   metadataBody,
+			WithResponse:   metadataOutput,
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "metadata", "1"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    int64(1),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*radarr.MetadataOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMetadataByID(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestAddMetadata(t *testing.T) {
+	t.Parallel()
+
+	input := &radarr.MetadataInput{
+		Name:           "Kodi (XBMC) / Emby",
+		Fields:         []*starr.FieldInput{{Name: "movieMetadata", Value: true}},
+		Implementation: "XbmcMetadata",
+		ConfigContract: "XbmcMetadataSettings",
+		Enable:         true,
+	}
+	request := `{"name":"Kodi (XBMC) / Emby","fields":[{"name":"movieMetadata","value":true}],` +
+		`"implementation":"XbmcMetadata","configContract":"XbmcMetadataSettings","enable":true}` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "metadata?forceSave=false"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  200,
+			WithRequest:     input,
+			ExpectedRequest: request,
+			ResponseBody:    metadataBody,
+			WithResponse:    metadataOutput,
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "metadata?forceSave=false"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  404,
+			WithRequest:     input,
+			ExpectedRequest: request,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    (*radarr.MetadataOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.AddMetadata(test.WithRequest.(*radarr.MetadataInput), false)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestUpdateMetadata(t *testing.T) {
+	t.Parallel()
+
+	input := &radarr.MetadataInput{
+		ID:             1,
+		Name:           "Kodi (XBMC) / Emby",
+		Fields:         []*starr.FieldInput{{Name: "movieMetadata", Value: false}},
+		Implementation: "XbmcMetadata",
+		ConfigContract: "XbmcMetadataSettings",
+		Enable:         true,
+	}
+	request := `{"id":1,"name":"Kodi (XBMC) / Emby","fields":[{"name":"movieMetadata","value":false}],` +
+		`"implementation":"XbmcMetadata","configContract":"XbmcMetadataSettings","enable":true}` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "metadata", "1?forceSave=true"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  200,
+			WithRequest:     input,
+			ExpectedRequest: request,
+			ResponseBody:    `{"id": 1, "name": "Kodi (XBMC) / Emby", "enable": true}`,
+			WithResponse:    &radarr.MetadataOutput{ID: 1, Name: "Kodi (XBMC) / Emby", Enable: true},
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "metadata", "1?forceSave=true"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  404,
+			WithRequest:     input,
+			ExpectedRequest: request,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    (*radarr.MetadataOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.UpdateMetadata(test.WithRequest.(*radarr.MetadataInput), true)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteMetadata(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "metadata", "1"),
+			ExpectedMethod: "DELETE",
+			ResponseStatus: 200,
+			WithRequest:    int64(1),
+			ResponseBody:   "{}",
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "metadata", "1"),
+			ExpectedMethod: "DELETE",
+			ResponseStatus: 404,
+			WithRequest:    int64(1),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteMetadata(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
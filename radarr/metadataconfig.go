@@ -0,0 +1,61 @@
+package radarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+)
+
+const bpMetadataConfig = APIver + "/config/metadata"
+
+// MetadataConfig represents the /config/metadata endpoint.
+// CertificationCountry is a lower case country code, like us, gb or de.
+type MetadataConfig struct {
+	ID                   int64  `json:"id"`
+	CertificationCountry string `json:"certificationCountry"`
+}
+
+// GetMetadataConfig returns the metadata config.
+func (r *Radarr) GetMetadataConfig() (*MetadataConfig, error) {
+	return r.GetMetadataConfigContext(context.Background())
+}
+
+// GetMetadataConfigContext returns the metadata config.
+func (r *Radarr) GetMetadataConfigContext(ctx context.Context) (*MetadataConfig, error) {
+	var output MetadataConfig
+
+	req := starr.Request{URI: bpMetadataConfig}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateMetadataConfig updates the metadata config.
+func (r *Radarr) UpdateMetadataConfig(metadataConfig *MetadataConfig) (*MetadataConfig, error) {
+	return r.UpdateMetadataConfigContext(context.Background(), metadataConfig)
+}
+
+// UpdateMetadataConfigContext updates the metadata config.
+func (r *Radarr) UpdateMetadataConfigContext(
+	ctx context.Context, metadataConfig *MetadataConfig,
+) (*MetadataConfig, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(metadataConfig); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMetadataConfig, err)
+	}
+
+	var output MetadataConfig
+
+	req := starr.Request{URI: path.Join(bpMetadataConfig, starr.Str(metadataConfig.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,91 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+const metadataConfigBody = `{"certificationCountry": "us", "id": 1}`
+
+func TestGetMetadataConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "config", "metadata"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody:   metadataConfigBody,
+			WithResponse:   &radarr.MetadataConfig{ID: 1, CertificationCountry: "us"},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "config", "metadata"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*radarr.MetadataConfig)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMetadataConfig()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestUpdateMetadataConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "config", "metadata", "1"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  202,
+			WithRequest:     &radarr.MetadataConfig{ID: 1, CertificationCountry: "gb"},
+			ExpectedRequest: `{"id":1,"certificationCountry":"gb"}` + "\n",
+			ResponseBody:    `{"certificationCountry": "gb", "id": 1}`,
+			WithResponse:    &radarr.MetadataConfig{ID: 1, CertificationCountry: "gb"},
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "config", "metadata", "1"),
+			ExpectedMethod:  "PUT",
+			WithRequest:     &radarr.MetadataConfig{ID: 1, CertificationCountry: "gb"},
+			ExpectedRequest: `{"id":1,"certificationCountry":"gb"}` + "\n",
+			ResponseStatus:  404,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    (*radarr.MetadataConfig)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.UpdateMetadataConfig(test.WithRequest.(*radarr.MetadataConfig))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -14,6 +14,18 @@ import (
 
 const bpMovieFile = APIver + "/moviefile"
 
+// MovieFileEditorInput is the input for EditMovieFiles (PUT /moviefile/editor).
+// Every movie file in MovieFileIDs gets the same values. Nil members are not changed.
+type MovieFileEditorInput struct {
+	MovieFileIDs []int64        `json:"movieFileIds"`
+	Languages    []*starr.Value `json:"languages,omitempty"`
+	Quality      *starr.Quality `json:"quality,omitempty"`
+	Edition      *string        `json:"edition,omitempty"`
+	ReleaseGroup *string        `json:"releaseGroup,omitempty"`
+	SceneName    *string        `json:"sceneName,omitempty"`
+	IndexerFlags *int64         `json:"indexerFlags,omitempty"`
+}
+
 // MovieFile is part of a Movie.
 type MovieFile struct {
 	ID                  int64                 `json:"id"`
@@ -154,3 +166,47 @@ func (r *Radarr) DeleteMovieFilesContext(ctx context.Context, movieFileIDs ...in
 
 	return nil
 }
+
+// EditMovieFiles applies bulk edits to movie files. Nil input members are not changed.
+func (r *Radarr) EditMovieFiles(in *MovieFileEditorInput) ([]*MovieFile, error) {
+	return r.EditMovieFilesContext(context.Background(), in)
+}
+
+// EditMovieFilesContext applies bulk edits to movie files. Nil input members are not changed.
+func (r *Radarr) EditMovieFilesContext(ctx context.Context, in *MovieFileEditorInput) ([]*MovieFile, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(in); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", path.Join(bpMovieFile, "editor"), err)
+	}
+
+	var output []*MovieFile
+
+	req := starr.Request{URI: path.Join(bpMovieFile, "editor"), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// UpdateMovieFiles updates many movie files in one request. Use this to set different values on each file.
+func (r *Radarr) UpdateMovieFiles(files []*MovieFile) ([]*MovieFile, error) {
+	return r.UpdateMovieFilesContext(context.Background(), files)
+}
+
+// UpdateMovieFilesContext updates many movie files in one request. Use this to set different values on each file.
+func (r *Radarr) UpdateMovieFilesContext(ctx context.Context, files []*MovieFile) ([]*MovieFile, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(files); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", path.Join(bpMovieFile, "bulk"), err)
+	}
+
+	var output []*MovieFile
+
+	req := starr.Request{URI: path.Join(bpMovieFile, "bulk"), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
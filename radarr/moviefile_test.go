@@ -0,0 +1,97 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestEditMovieFiles(t *testing.T) {
+	t.Parallel()
+
+	edition := "IMAX"
+	input := &radarr.MovieFileEditorInput{MovieFileIDs: []int64{5, 6}, Edition: &edition}
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "moviefile", "editor"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"movieFileIds":[5,6],"edition":"IMAX"}` + "\n",
+			ResponseStatus:  http.StatusAccepted,
+			ResponseBody:    `[{"id":5,"movieId":2,"edition":"IMAX"},{"id":6,"movieId":3,"edition":"IMAX"}]`,
+			WithResponse: []*radarr.MovieFile{
+				{ID: 5, MovieID: 2, Edition: "IMAX"},
+				{ID: 6, MovieID: 3, Edition: "IMAX"},
+			},
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "moviefile", "editor"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"movieFileIds":[5,6],"edition":"IMAX"}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    ([]*radarr.MovieFile)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditMovieFiles(input)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestUpdateMovieFiles(t *testing.T) {
+	t.Parallel()
+
+	const request = `[{"id":5,"movieId":2,"relativePath":"","path":"","size":0,"dateAdded":"0001-01-01T00:00:00Z",` +
+		`"sceneName":"","indexerFlags":0,"customFormatScore":0,"originalFilePath":"","qualityCutoffNotMet":false,` +
+		`"languages":null,"releaseGroup":"","edition":"IMAX"}]` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "moviefile", "bulk"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: request,
+			ResponseStatus:  http.StatusAccepted,
+			ResponseBody:    `[{"id":5,"movieId":2,"edition":"IMAX"}]`,
+			WithResponse:    []*radarr.MovieFile{{ID: 5, MovieID: 2, Edition: "IMAX"}},
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "moviefile", "bulk"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: request,
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    ([]*radarr.MovieFile)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.UpdateMovieFiles([]*radarr.MovieFile{{ID: 5, MovieID: 2, Edition: "IMAX"}})
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"path"
 
 	"golift.io/starr"
 )
@@ -74,3 +76,48 @@ func (r *Radarr) UpdateNamingContext(ctx context.Context, naming *Naming) (*Nami
 
 	return &output, nil
 }
+
+// NamingExamples are the file and folder names rendered from a naming config by PreviewNaming.
+// An example is empty when its format is empty or invalid.
+type NamingExamples struct {
+	MovieExample       string `json:"movieExample"`
+	MovieFolderExample string `json:"movieFolderExample"`
+}
+
+// PreviewNaming renders example file and folder names from a naming config, without saving it.
+func (r *Radarr) PreviewNaming(naming *Naming) (*NamingExamples, error) {
+	return r.PreviewNamingContext(context.Background(), naming)
+}
+
+// PreviewNamingContext renders example file and folder names from a naming config, without saving it.
+func (r *Radarr) PreviewNamingContext(ctx context.Context, naming *Naming) (*NamingExamples, error) {
+	var output NamingExamples
+
+	req := starr.Request{URI: path.Join(bpNaming, "examples"), Query: naming.values()}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// values turns a naming config into the query parameters for the examples endpoint.
+func (n *Naming) values() url.Values {
+	if n == nil {
+		n = &Naming{}
+	}
+
+	params := url.Values{
+		"renameMovies":             {starr.Str(n.RenameMovies)},
+		"replaceIllegalCharacters": {starr.Str(n.ReplaceIllegalCharacters)},
+		"standardMovieFormat":      {n.StandardMovieFormat},
+		"movieFolderFormat":        {n.MovieFolderFormat},
+	}
+
+	// An empty value is not a valid colon replacement, so let Radarr use its default.
+	if n.ColonReplacementFormat != "" {
+		params.Set("colonReplacementFormat", string(n.ColonReplacementFormat))
+	}
+
+	return params
+}
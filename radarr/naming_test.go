@@ -118,3 +118,52 @@ func TestUpdateNaming(t *testing.T) {
 		})
 	}
 }
+
+func TestPreviewNaming(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "config", "naming", "examples") +
+				"?colonReplacementFormat=dash&movieFolderFormat=%7BMovie+Title%7D&renameMovies=true" +
+				"&replaceIllegalCharacters=false&standardMovieFormat=%7BMovie+Title%7D+%7BQuality+Title%7D",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"movieExample":"The Movie - Title Bluray-1080p","movieFolderExample":"The Movie - Title"}`,
+			WithRequest: &radarr.Naming{
+				RenameMovies:           true,
+				ColonReplacementFormat: radarr.ColonReplaceWithDash,
+				StandardMovieFormat:    "{Movie Title} {Quality Title}",
+				MovieFolderFormat:      "{Movie Title}",
+			},
+			WithResponse: &radarr.NamingExamples{
+				MovieExample:       "The Movie - Title Bluray-1080p",
+				MovieFolderExample: "The Movie - Title",
+			},
+		},
+		{
+			Name: "404",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "config", "naming", "examples") +
+				"?movieFolderFormat=&renameMovies=false" +
+				"&replaceIllegalCharacters=false&standardMovieFormat=",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    (*radarr.Naming)(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*radarr.NamingExamples)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.PreviewNaming(test.WithRequest.(*radarr.Naming))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -67,6 +67,34 @@ type NotificationOutput struct {
 	Fields                              []*starr.FieldOutput `json:"fields"`
 }
 
+// ToInput converts the output of a notification into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (n *NotificationOutput) ToInput() *NotificationInput {
+	if n == nil {
+		return nil
+	}
+
+	return &NotificationInput{
+		OnGrab:                      n.OnGrab,
+		OnDownload:                  n.OnDownload,
+		OnUpgrade:                   n.OnUpgrade,
+		OnRename:                    n.OnRename,
+		OnMovieAdded:                n.OnMovieAdded,
+		OnMovieDelete:               n.OnMovieDelete,
+		OnMovieFileDelete:           n.OnMovieFileDelete,
+		OnMovieFileDeleteForUpgrade: n.OnMovieFileDeleteForUpgrade,
+		OnHealthIssue:               n.OnHealthIssue,
+		OnApplicationUpdate:         n.OnApplicationUpdate,
+		IncludeHealthWarnings:       n.IncludeHealthWarnings,
+		ID:                          n.ID,
+		Name:                        n.Name,
+		Implementation:              n.Implementation,
+		ConfigContract:              n.ConfigContract,
+		Tags:                        n.Tags,
+		Fields:                      starr.ToFieldInputs(n.Fields),
+	}
+}
+
 // GetNotifications returns all configured notifications.
 func (r *Radarr) GetNotifications() ([]*NotificationOutput, error) {
 	return r.GetNotificationsContext(context.Background())
@@ -0,0 +1,64 @@
+package radarr_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetQualityDefinitionLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   "/api/v3/qualityDefinition/limits",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"min": 0, "max": 2000}`,
+			WithResponse:   &radarr.QualityDefinitionLimits{Min: 0, Max: 2000},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   "/api/v3/qualityDefinition/limits",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*radarr.QualityDefinitionLimits)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetQualityDefinitionLimits()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestQualityDefinitionSize(t *testing.T) {
+	t.Parallel()
+
+	definition := &radarr.QualityDefinition{MinSize: 10, PrefSize: 50, MaxSize: 100}
+	runtime := 120 * time.Minute
+	size := definition.Size(runtime)
+	assert.Equal(t, &radarr.QualitySize{Min: 1200 << 20, Preferred: 6000 << 20, Max: 12000 << 20}, size)
+	assert.True(t, definition.Accepts(5<<30, runtime))
+	assert.False(t, definition.Accepts(1<<30, runtime), "too small")
+	assert.False(t, definition.Accepts(12<<30, runtime), "too big")
+	assert.True(t, definition.Accepts(1<<40, 0), "a zero runtime accepts every size")
+
+	definition.MaxSize = 0
+	assert.True(t, definition.Accepts(1<<40, runtime), "a zero max size is unlimited")
+}
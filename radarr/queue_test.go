@@ -0,0 +1,105 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetQueueRecords(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "queue", "details") + "?movieId=7",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id":3,"movieId":7,"title":"Sintel.2010.1080p","status":"delay","protocol":"torrent"}]`,
+			WithRequest:    int64(7),
+			WithResponse: []*radarr.QueueRecord{
+				{ID: 3, MovieID: 7, Title: "Sintel.2010.1080p", Status: "delay", Protocol: starr.ProtocolTorrent},
+			},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "queue", "details"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    int64(0),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*radarr.QueueRecord)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetQueueRecords(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetQueueStatus(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "queue", "status"),
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `{"totalCount":4,"count":3,"unknownCount":1,"errors":true,"warnings":false}`,
+		WithResponse:   &radarr.QueueStatus{TotalCount: 4, Count: 3, UnknownCount: 1, Errors: true},
+	}
+
+	client := radarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	output, err := client.GetQueueStatus()
+	require.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+}
+
+func TestDeleteQueueBulk(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "defaults",
+			ExpectedPath:    path.Join("/", starr.API, radarr.APIver, "queue", "bulk") + "?removeFromClient=true",
+			ExpectedMethod:  http.MethodDelete,
+			ExpectedRequest: `{"ids":[1,2,3]}` + "\n",
+			ResponseStatus:  http.StatusOK,
+			WithRequest:     (*starr.QueueDeleteOpts)(nil),
+		},
+		{
+			Name: "options",
+			ExpectedPath: path.Join("/", starr.API, radarr.APIver, "queue", "bulk") +
+				"?blocklist=true&changeCategory=false&removeFromClient=false&skipRedownload=true",
+			ExpectedMethod:  http.MethodDelete,
+			ExpectedRequest: `{"ids":[1,2,3]}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithRequest:     &starr.QueueDeleteOpts{RemoveFromClient: starr.False(), BlockList: true, SkipRedownload: true},
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteQueueBulk([]int64{1, 2, 3}, test.WithRequest.(*starr.QueueDeleteOpts))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
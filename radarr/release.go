@@ -9,9 +9,13 @@ import (
 	"time"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
-const bpRelease = APIver + "/release"
+const (
+	bpRelease     = APIver + "/release"
+	bpReleasePush = bpRelease + "/push"
+)
 
 // Release is the output from the Radarr release endpoint.
 type Release struct {
@@ -137,3 +141,30 @@ func (r *Radarr) GrabReleaseContext(ctx context.Context, release *Release) (*Rel
 
 	return &output, nil
 }
+
+// PushRelease is the /api/v3/release/push resource.
+type PushRelease = starrshared.PushRelease
+
+// PushRelease sends a release to Radarr. The output has its decisions, usually one:
+// Approved is true if the release was grabbed, and Rejections explains why it was not.
+func (r *Radarr) PushRelease(push *PushRelease) ([]*Release, error) {
+	return r.PushReleaseContext(context.Background(), push)
+}
+
+// PushReleaseContext sends a release to Radarr. The output has its decisions, usually one:
+// Approved is true if the release was grabbed, and Rejections explains why it was not.
+func (r *Radarr) PushReleaseContext(ctx context.Context, push *PushRelease) ([]*Release, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(push); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpReleasePush, err)
+	}
+
+	var output []*Release
+
+	req := starr.Request{URI: bpReleasePush, Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,41 @@
+package radarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestPushRelease(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, radarr.APIver, "release", "push"),
+		ExpectedMethod: http.MethodPost,
+		ExpectedRequest: `{"title":"Movie (2020) 2160p WEB-DL","downloadUrl":"http://indexer/get/1",` +
+			`"protocol":"torrent","publishDate":"2020-01-01T00:00:00Z","indexer":"Indexer"}` + "\n",
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `[{"title":"Movie (2020) 2160p WEB-DL","approved":false,"rejections":["Unknown Movie"]}]`,
+	}
+	mockServer := test.GetMockServer(t)
+	client := radarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+
+	output, err := client.PushRelease(&radarr.PushRelease{
+		Title:       "Movie (2020) 2160p WEB-DL",
+		DownloadURL: "http://indexer/get/1",
+		Protocol:    starr.ProtocolTorrent,
+		PublishDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Indexer:     "Indexer",
+	})
+	require.NoError(t, err)
+	require.Len(t, output, 1)
+	assert.False(t, output[0].Approved)
+	assert.Equal(t, []string{"Unknown Movie"}, output[0].Rejections)
+}
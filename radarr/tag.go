@@ -112,6 +112,7 @@ type TagDetails struct {
 	DelayProfileIDs   []int  `json:"delayProfileIds,omitempty"`
 	ImportListIDs     []int  `json:"importListIds,omitempty"`
 	NotificationIDs   []int  `json:"notificationIds,omitempty"`
+	ReleaseProfileIDs []int  `json:"releaseProfileIds,omitempty"`
 	IndexerIDs        []int  `json:"indexerIds,omitempty"`
 	DownloadClientIDs []int  `json:"downloadClientIds,omitempty"`
 	AutoTagIDs        []int  `json:"autoTagIds,omitempty"`
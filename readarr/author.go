@@ -14,6 +14,20 @@ import (
 
 const bpAuthor = APIver + "/author"
 
+// AuthorEditorInput is the request body for EditAuthors and DeleteAuthors (PUT and DELETE /author/editor).
+type AuthorEditorInput struct {
+	AuthorIDs         []int64         `json:"authorIds,omitempty"`
+	Monitored         *bool           `json:"monitored,omitempty"`
+	MonitorNewItems   string          `json:"monitorNewItems,omitempty"`
+	QualityProfileID  *int            `json:"qualityProfileId,omitempty"`
+	MetadataProfileID *int            `json:"metadataProfileId,omitempty"`
+	RootFolderPath    string          `json:"rootFolderPath,omitempty"`
+	Tags              []int           `json:"tags,omitempty"`
+	ApplyTags         starr.ApplyTags `json:"applyTags,omitempty"`
+	MoveFiles         bool            `json:"moveFiles,omitempty"`
+	DeleteFiles       bool            `json:"deleteFiles,omitempty"`
+}
+
 // GetAuthors returns all authors in the library.
 func (r *Readarr) GetAuthors() ([]*Author, error) {
 	return r.GetAuthorsContext(context.Background())
@@ -207,3 +221,45 @@ func (r *Readarr) DeleteAuthorContext(ctx context.Context, authorID int64, delet
 
 	return nil
 }
+
+// EditAuthors applies bulk edits to authors.
+func (r *Readarr) EditAuthors(in *AuthorEditorInput) ([]*Author, error) {
+	return r.EditAuthorsContext(context.Background(), in)
+}
+
+// EditAuthorsContext applies bulk edits to authors.
+func (r *Readarr) EditAuthorsContext(ctx context.Context, in *AuthorEditorInput) ([]*Author, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(in); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", path.Join(bpAuthor, "editor"), err)
+	}
+
+	var output []*Author
+
+	req := starr.Request{URI: path.Join(bpAuthor, "editor"), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteAuthors applies bulk deletes to authors. Only AuthorIDs and DeleteFiles are used.
+func (r *Readarr) DeleteAuthors(in *AuthorEditorInput) error {
+	return r.DeleteAuthorsContext(context.Background(), in)
+}
+
+// DeleteAuthorsContext applies bulk deletes to authors. Only AuthorIDs and DeleteFiles are used.
+func (r *Readarr) DeleteAuthorsContext(ctx context.Context, in *AuthorEditorInput) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(in); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", path.Join(bpAuthor, "editor"), err)
+	}
+
+	req := starr.Request{URI: path.Join(bpAuthor, "editor"), Body: &body}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
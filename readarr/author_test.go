@@ -0,0 +1,92 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestEditAuthors(t *testing.T) {
+	t.Parallel()
+
+	monitored := true
+	input := &readarr.AuthorEditorInput{AuthorIDs: []int64{1, 2}, Monitored: &monitored}
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "author", "editor"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"authorIds":[1,2],"monitored":true}` + "\n",
+			ResponseStatus:  http.StatusAccepted,
+			ResponseBody:    `[{"id":1,"authorName":"Frank Herbert","monitored":true},{"id":2,"monitored":true}]`,
+			WithResponse: []*readarr.Author{
+				{ID: 1, AuthorName: "Frank Herbert", Monitored: true},
+				{ID: 2, Monitored: true},
+			},
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "author", "editor"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"authorIds":[1,2],"monitored":true}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    ([]*readarr.Author)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditAuthors(input)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteAuthors(t *testing.T) {
+	t.Parallel()
+
+	input := &readarr.AuthorEditorInput{AuthorIDs: []int64{1}, DeleteFiles: true}
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "author", "editor"),
+			ExpectedMethod:  http.MethodDelete,
+			ExpectedRequest: `{"authorIds":[1],"deleteFiles":true}` + "\n",
+			ResponseStatus:  http.StatusOK,
+			ResponseBody:    `{}`,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "author", "editor"),
+			ExpectedMethod:  http.MethodDelete,
+			ExpectedRequest: `{"authorIds":[1],"deleteFiles":true}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteAuthors(input)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
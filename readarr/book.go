@@ -14,6 +14,14 @@ import (
 
 const bpBook = APIver + "/book"
 
+// BookEditorInput is the request body for EditBooks and DeleteBooks (PUT and DELETE /book/editor).
+type BookEditorInput struct {
+	BookIDs                []int64 `json:"bookIds,omitempty"`
+	Monitored              *bool   `json:"monitored,omitempty"`
+	DeleteFiles            *bool   `json:"deleteFiles,omitempty"`
+	AddImportListExclusion *bool   `json:"addImportListExclusion,omitempty"`
+}
+
 // Book is the /api/v1/book endpoint among others, and gets used across this package.
 type Book struct {
 	Added          time.Time      `json:"added"`
@@ -41,28 +49,6 @@ type Book struct {
 	Author         *Author        `json:"author"`
 }
 
-// Edition is more Book meta data.
-type Edition struct {
-	ID               int64          `json:"id"`
-	BookID           int64          `json:"bookId"`
-	ForeignEditionID string         `json:"foreignEditionId"`
-	TitleSlug        string         `json:"titleSlug"`
-	Isbn13           string         `json:"isbn13"`
-	Asin             string         `json:"asin"`
-	Title            string         `json:"title"`
-	Overview         string         `json:"overview"`
-	Format           string         `json:"format"`
-	Publisher        string         `json:"publisher"`
-	PageCount        int            `json:"pageCount"`
-	ReleaseDate      time.Time      `json:"releaseDate"`
-	Images           []*starr.Image `json:"images"`
-	Links            []*starr.Link  `json:"links"`
-	Ratings          *starr.Ratings `json:"ratings"`
-	Monitored        bool           `json:"monitored"`
-	ManualAdd        bool           `json:"manualAdd"`
-	IsEbook          bool           `json:"isEbook"`
-}
-
 // AddBookInput is the input to add a book.
 type AddBookInput struct {
 	Monitored     bool              `json:"monitored"`
@@ -272,3 +258,64 @@ func (r *Readarr) MonitorBooksContext(ctx context.Context, bookIDs []int64, moni
 
 	return output, nil
 }
+
+// GetBookOverview returns the overview of a book's monitored edition.
+func (r *Readarr) GetBookOverview(bookID int64) (string, error) {
+	return r.GetBookOverviewContext(context.Background(), bookID)
+}
+
+// GetBookOverviewContext returns the overview of a book's monitored edition.
+func (r *Readarr) GetBookOverviewContext(ctx context.Context, bookID int64) (string, error) {
+	var output struct {
+		Overview string `json:"overview"`
+	}
+
+	req := starr.Request{URI: path.Join(bpBook, starr.Str(bookID), "overview")}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return "", fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output.Overview, nil
+}
+
+// EditBooks applies bulk edits to books. Only BookIDs and Monitored are used.
+func (r *Readarr) EditBooks(in *BookEditorInput) ([]*Book, error) {
+	return r.EditBooksContext(context.Background(), in)
+}
+
+// EditBooksContext applies bulk edits to books. Only BookIDs and Monitored are used.
+func (r *Readarr) EditBooksContext(ctx context.Context, in *BookEditorInput) ([]*Book, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(in); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", path.Join(bpBook, "editor"), err)
+	}
+
+	var output []*Book
+
+	req := starr.Request{URI: path.Join(bpBook, "editor"), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteBooks applies bulk deletes to books.
+func (r *Readarr) DeleteBooks(in *BookEditorInput) error {
+	return r.DeleteBooksContext(context.Background(), in)
+}
+
+// DeleteBooksContext applies bulk deletes to books.
+func (r *Readarr) DeleteBooksContext(ctx context.Context, in *BookEditorInput) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(in); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", path.Join(bpBook, "editor"), err)
+	}
+
+	req := starr.Request{URI: path.Join(bpBook, "editor"), Body: &body}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestEditBooks(t *testing.T) {
+	t.Parallel()
+
+	monitored := false
+	input := &readarr.BookEditorInput{BookIDs: []int64{7, 8}, Monitored: &monitored}
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "book", "editor"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"bookIds":[7,8],"monitored":false}` + "\n",
+			ResponseStatus:  http.StatusAccepted,
+			ResponseBody:    `[{"id":7,"title":"Dune"},{"id":8,"title":"Dune Messiah"}]`,
+			WithResponse:    []*readarr.Book{{ID: 7, Title: "Dune"}, {ID: 8, Title: "Dune Messiah"}},
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "book", "editor"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"bookIds":[7,8],"monitored":false}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    ([]*readarr.Book)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditBooks(input)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteBooks(t *testing.T) {
+	t.Parallel()
+
+	deleteFiles := true
+	test := &starrtest.MockData{
+		ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "book", "editor"),
+		ExpectedMethod:  http.MethodDelete,
+		ExpectedRequest: `{"bookIds":[7],"deleteFiles":true}` + "\n",
+		ResponseStatus:  http.StatusOK,
+		ResponseBody:    `{}`,
+	}
+
+	client := readarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	err := client.DeleteBooks(&readarr.BookEditorInput{BookIDs: []int64{7}, DeleteFiles: &deleteFiles})
+	require.NoError(t, err)
+}
+
+func TestGetBookOverview(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "book", "7", "overview"),
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `{"id":7,"overview":"A desert planet."}`,
+	}
+
+	client := readarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	output, err := client.GetBookOverview(7)
+	require.NoError(t, err)
+	assert.Equal(t, "A desert planet.", output)
+}
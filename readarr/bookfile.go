@@ -14,6 +14,12 @@ import (
 
 const bpBookFile = APIver + "/bookfile"
 
+// BookFileEditorInput is the request body for EditBookFiles (PUT /bookfile/editor).
+type BookFileEditorInput struct {
+	BookFileIDs []int64        `json:"bookFileIds"`
+	Quality     *starr.Quality `json:"quality,omitempty"`
+}
+
 // BookFile represents the data from the bookfile endpoint.
 type BookFile struct {
 	AuthorID            int64          `json:"authorId"`
@@ -207,3 +213,25 @@ func (r *Readarr) DeleteBookFilesContext(ctx context.Context, bookFileIDs []int6
 
 	return nil
 }
+
+// EditBookFiles applies bulk edits to book files. Only the quality can be changed.
+func (r *Readarr) EditBookFiles(in *BookFileEditorInput) ([]*BookFile, error) {
+	return r.EditBookFilesContext(context.Background(), in)
+}
+
+// EditBookFilesContext applies bulk edits to book files. Only the quality can be changed.
+func (r *Readarr) EditBookFilesContext(ctx context.Context, in *BookFileEditorInput) ([]*BookFile, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(in); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", path.Join(bpBookFile, "editor"), err)
+	}
+
+	var output []*BookFile
+
+	req := starr.Request{URI: path.Join(bpBookFile, "editor"), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
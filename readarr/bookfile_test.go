@@ -0,0 +1,53 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestEditBookFiles(t *testing.T) {
+	t.Parallel()
+
+	quality := &starr.Quality{Quality: &starr.BaseQuality{ID: 3, Name: "EPUB"}}
+	input := &readarr.BookFileEditorInput{BookFileIDs: []int64{31}, Quality: quality}
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "bookfile", "editor"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"bookFileIds":[31],"quality":{"quality":{"id":3,"name":"EPUB"},"allowed":false}}` + "\n",
+			ResponseStatus:  http.StatusAccepted,
+			ResponseBody:    `[{"id":31,"authorId":2,"bookId":9,"quality":{"quality":{"id":3,"name":"EPUB"}}}]`,
+			WithResponse:    []*readarr.BookFile{{ID: 31, AuthorID: 2, BookID: 9, Quality: quality}},
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "bookfile", "editor"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"bookFileIds":[31],"quality":{"quality":{"id":3,"name":"EPUB"},"allowed":false}}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    ([]*readarr.BookFile)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditBookFiles(input)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package readarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"golift.io/starr"
+)
+
+const bpBookshelf = APIver + "/bookshelf"
+
+// Bookshelf is the input for UpdateBookshelf, and the /api/v1/bookshelf endpoint.
+// It sets the monitored state of many authors, and their books, in one request.
+type Bookshelf struct {
+	Authors           []*BookshelfAuthor `json:"authors"`
+	MonitoringOptions *MonitoringOptions `json:"monitoringOptions,omitempty"`
+	MonitorNewItems   string             `json:"monitorNewItems,omitempty"` // all, none, new
+}
+
+// BookshelfAuthor is part of a Bookshelf.
+// Books only need an ID and Monitored; every book in the list is updated.
+type BookshelfAuthor struct {
+	ID        int64   `json:"id"`
+	Monitored *bool   `json:"monitored,omitempty"`
+	Books     []*Book `json:"books,omitempty"`
+}
+
+// MonitoringOptions select the books to monitor on every author in a Bookshelf.
+type MonitoringOptions struct {
+	// Monitor is one of all, future, missing, existing, latest, first, none or unknown.
+	Monitor        string   `json:"monitor,omitempty"`
+	BooksToMonitor []string `json:"booksToMonitor,omitempty"` // Foreign book IDs.
+	Monitored      bool     `json:"monitored"`
+}
+
+// UpdateBookshelf sets the monitored state of many authors and books.
+func (r *Readarr) UpdateBookshelf(bookshelf *Bookshelf) error {
+	return r.UpdateBookshelfContext(context.Background(), bookshelf)
+}
+
+// UpdateBookshelfContext sets the monitored state of many authors and books.
+func (r *Readarr) UpdateBookshelfContext(ctx context.Context, bookshelf *Bookshelf) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(bookshelf); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpBookshelf, err)
+	}
+
+	// This endpoint answers with an empty body.
+	req := starr.Request{URI: starr.SetAPIPath(bpBookshelf), Body: &body}
+
+	resp, err := r.Post(ctx, req)
+	if err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestUpdateBookshelf(t *testing.T) {
+	t.Parallel()
+
+	bookshelf := &readarr.Bookshelf{
+		Authors: []*readarr.BookshelfAuthor{
+			{ID: 1, Monitored: starr.True()},
+			{ID: 2, Monitored: starr.False()},
+		},
+		MonitoringOptions: &readarr.MonitoringOptions{Monitor: "future", Monitored: true},
+		MonitorNewItems:   "new",
+	}
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "202",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "bookshelf"),
+			ExpectedMethod: http.MethodPost,
+			ExpectedRequest: `{"authors":[{"id":1,"monitored":true},{"id":2,"monitored":false}],` +
+				`"monitoringOptions":{"monitor":"future","monitored":true},"monitorNewItems":"new"}` + "\n",
+			ResponseStatus: http.StatusAccepted,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "bookshelf"),
+			ExpectedMethod: http.MethodPost,
+			ExpectedRequest: `{"authors":[{"id":1,"monitored":true},{"id":2,"monitored":false}],` +
+				`"monitoringOptions":{"monitor":"future","monitored":true},"monitorNewItems":"new"}` + "\n",
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.UpdateBookshelf(bookshelf)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package readarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const (
+	bpConfigHost        = APIver + "/config/host"
+	bpConfigUI          = APIver + "/config/ui"
+	bpConfigDevelopment = APIver + "/config/development"
+)
+
+// HostConfig is the /api/v1/config/host resource.
+type HostConfig = starrshared.HostConfig
+
+// UIConfig is the /api/v1/config/ui resource.
+type UIConfig = starrshared.UIConfig
+
+// DevelopmentConfig is the /api/v1/config/development resource.
+type DevelopmentConfig struct {
+	ID                 int    `json:"id,omitempty"`
+	MetadataSource     string `json:"metadataSource,omitempty"`
+	ConsoleLogLevel    string `json:"consoleLogLevel,omitempty"`
+	LogSQL             bool   `json:"logSql"`
+	LogRotate          int    `json:"logRotate"`
+	FilterSentryEvents bool   `json:"filterSentryEvents"`
+}
+
+// GetHostConfig returns the host configuration.
+func (r *Readarr) GetHostConfig() (*HostConfig, error) {
+	return r.GetHostConfigContext(context.Background())
+}
+
+// GetHostConfigContext returns the host configuration.
+func (r *Readarr) GetHostConfigContext(ctx context.Context) (*HostConfig, error) {
+	var output HostConfig
+
+	req := starr.Request{URI: bpConfigHost}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetHostConfigByID returns the host configuration for the given id.
+func (r *Readarr) GetHostConfigByID(id int) (*HostConfig, error) {
+	return r.GetHostConfigByIDContext(context.Background(), id)
+}
+
+// GetHostConfigByIDContext returns the host configuration for the given id.
+func (r *Readarr) GetHostConfigByIDContext(ctx context.Context, id int) (*HostConfig, error) {
+	var output HostConfig
+
+	req := starr.Request{URI: path.Join(bpConfigHost, starr.Str(id))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateHostConfig updates the host configuration.
+func (r *Readarr) UpdateHostConfig(input *HostConfig) (*HostConfig, error) {
+	return r.UpdateHostConfigContext(context.Background(), input)
+}
+
+// UpdateHostConfigContext updates the host configuration.
+func (r *Readarr) UpdateHostConfigContext(ctx context.Context, input *HostConfig) (*HostConfig, error) {
+	var output HostConfig
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpConfigHost, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpConfigHost, starr.Str(input.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetUIConfig returns the UI configuration.
+func (r *Readarr) GetUIConfig() (*UIConfig, error) {
+	return r.GetUIConfigContext(context.Background())
+}
+
+// GetUIConfigContext returns the UI configuration.
+func (r *Readarr) GetUIConfigContext(ctx context.Context) (*UIConfig, error) {
+	var output UIConfig
+
+	req := starr.Request{URI: bpConfigUI}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetUIConfigByID returns the UI configuration for the given id.
+func (r *Readarr) GetUIConfigByID(id int) (*UIConfig, error) {
+	return r.GetUIConfigByIDContext(context.Background(), id)
+}
+
+// GetUIConfigByIDContext returns the UI configuration for the given id.
+func (r *Readarr) GetUIConfigByIDContext(ctx context.Context, id int) (*UIConfig, error) {
+	var output UIConfig
+
+	req := starr.Request{URI: path.Join(bpConfigUI, starr.Str(id))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateUIConfig updates the UI configuration.
+func (r *Readarr) UpdateUIConfig(input *UIConfig) (*UIConfig, error) {
+	return r.UpdateUIConfigContext(context.Background(), input)
+}
+
+// UpdateUIConfigContext updates the UI configuration.
+func (r *Readarr) UpdateUIConfigContext(ctx context.Context, input *UIConfig) (*UIConfig, error) {
+	var output UIConfig
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpConfigUI, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpConfigUI, starr.Str(input.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetDevelopmentConfig returns the development configuration.
+func (r *Readarr) GetDevelopmentConfig() (*DevelopmentConfig, error) {
+	return r.GetDevelopmentConfigContext(context.Background())
+}
+
+// GetDevelopmentConfigContext returns the development configuration.
+func (r *Readarr) GetDevelopmentConfigContext(ctx context.Context) (*DevelopmentConfig, error) {
+	var output DevelopmentConfig
+
+	req := starr.Request{URI: bpConfigDevelopment}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetDevelopmentConfigByID returns the development configuration for the given id.
+func (r *Readarr) GetDevelopmentConfigByID(id int) (*DevelopmentConfig, error) {
+	return r.GetDevelopmentConfigByIDContext(context.Background(), id)
+}
+
+// GetDevelopmentConfigByIDContext returns the development configuration for the given id.
+func (r *Readarr) GetDevelopmentConfigByIDContext(ctx context.Context, id int) (*DevelopmentConfig, error) {
+	var output DevelopmentConfig
+
+	req := starr.Request{URI: path.Join(bpConfigDevelopment, starr.Str(id))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateDevelopmentConfig updates the development configuration.
+func (r *Readarr) UpdateDevelopmentConfig(input *DevelopmentConfig) (*DevelopmentConfig, error) {
+	return r.UpdateDevelopmentConfigContext(context.Background(), input)
+}
+
+// UpdateDevelopmentConfigContext updates the development configuration.
+func (r *Readarr) UpdateDevelopmentConfigContext(
+	ctx context.Context, input *DevelopmentConfig,
+) (*DevelopmentConfig, error) {
+	var output DevelopmentConfig
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(input); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpConfigDevelopment, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpConfigDevelopment, starr.Str(input.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
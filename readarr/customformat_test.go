@@ -0,0 +1,102 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetCustomFormatSchema(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "customformat", "schema"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id":0,"name":"","implementation":"ReleaseTitleSpecification",
+				"implementationName":"Release Title","negate":false,"required":false,
+				"fields":[{"order":0,"name":"value","label":"Regular Expression","type":"textbox"}],
+				"presets":[{"name":"Retail","implementation":"ReleaseTitleSpecification",
+					"fields":[{"name":"value","value":"\\bRetail\\b"}]}]}]`,
+			WithResponse: []*readarr.CustomFormatOutputSpec{{
+				Implementation:     "ReleaseTitleSpecification",
+				ImplementationName: "Release Title",
+				Fields: []*starr.FieldOutput{
+					{Name: "value", Label: "Regular Expression", Type: "textbox"},
+				},
+				Presets: []*readarr.CustomFormatOutputSpec{{
+					Name:           "Retail",
+					Implementation: "ReleaseTitleSpecification",
+					Fields:         []*starr.FieldOutput{{Name: "value", Value: `\bRetail\b`}},
+				}},
+			}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "customformat", "schema"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*readarr.CustomFormatOutputSpec)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetCustomFormatSchema()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestAddCustomFormat(t *testing.T) {
+	t.Parallel()
+
+	input := &readarr.CustomFormatInput{
+		Name: "Retail",
+		Specifications: []*readarr.CustomFormatInputSpec{{
+			Name:           "Retail",
+			Implementation: "ReleaseTitleSpecification",
+			Fields:         []*starr.FieldInput{{Name: "value", Value: `\bRetail\b`}},
+		}},
+	}
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "customformat"),
+		ExpectedMethod: http.MethodPost,
+		ExpectedRequest: `{"name":"Retail","includeCustomFormatWhenRenaming":false,"specifications":[{"name":"Retail",` +
+			`"implementation":"ReleaseTitleSpecification","negate":false,"required":false,` +
+			`"fields":[{"name":"value","value":"\\bRetail\\b"}]}]}` + "\n",
+		ResponseStatus: http.StatusCreated,
+		ResponseBody: `{"id":3,"name":"Retail","includeCustomFormatWhenRenaming":false,"specifications":[{"id":0,
+			"name":"Retail","implementation":"ReleaseTitleSpecification","fields":[{"name":"value","value":"\\bRetail\\b"}]}]}`,
+		WithResponse: &readarr.CustomFormatOutput{
+			ID:   3,
+			Name: "Retail",
+			Specifications: []*readarr.CustomFormatOutputSpec{{
+				Name:           "Retail",
+				Implementation: "ReleaseTitleSpecification",
+				Fields:         []*starr.FieldOutput{{Name: "value", Value: `\bRetail\b`}},
+			}},
+		},
+	}
+
+	client := readarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	output, err := client.AddCustomFormat(input)
+	require.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+	assert.Equal(t, input.Specifications[0].Fields, output.ToInput().Specifications[0].Fields)
+}
@@ -486,3 +486,42 @@ func TestDeleteDownloadClient(t *testing.T) {
 		})
 	}
 }
+
+const importListResponseBody = `{
+    "enableAutomaticAdd": true,
+    "shouldMonitorExisting": true,
+    "shouldSearch": true,
+    "listOrder": 1,
+    "id": 3,
+    "qualityProfileId": 4,
+    "metadataProfileId": 5,
+    "shouldMonitor": "entireAuthor",
+    "rootFolderPath": "/books",
+    "monitorNewItems": "all",
+    "listType": "goodreads",
+    "name": "To Read",
+    "implementationName": "Goodreads Bookshelves",
+    "implementation": "GoodreadsBookshelf",
+    "configContract": "GoodreadsBookshelfImportListSettings",
+    "infoLink": "https://wiki.servarr.com/readarr/supported#goodreadsbookshelf",
+    "tags": [1],
+    "fields": [{"order": 0, "name": "bookshelfIds", "label": "Bookshelves", "value": ["to-read"], "type": "bookshelf"}]
+}`
+
+func TestOutputToInput(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(*testing.T){
+		"DownloadClient": starrtest.ToInputCase((*readarr.DownloadClientOutput).ToInput, downloadClientResponseBody),
+		"ImportList":     starrtest.ToInputCase((*readarr.ImportListOutput).ToInput, importListResponseBody),
+		"Indexer":        starrtest.ToInputCase((*readarr.IndexerOutput).ToInput, indexerResponseBody),
+		"Notification":   starrtest.ToInputCase((*readarr.NotificationOutput).ToInput, notificationResponseBody),
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			test(t)
+		})
+	}
+}
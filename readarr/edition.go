@@ -0,0 +1,61 @@
+package readarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"golift.io/starr"
+)
+
+const bpEdition = APIver + "/edition"
+
+// Edition is more Book meta data, and the /api/v1/edition endpoint.
+// A book has one monitored edition; that is the edition Readarr searches for.
+type Edition struct {
+	ID               int64          `json:"id"`
+	BookID           int64          `json:"bookId"`
+	ForeignEditionID string         `json:"foreignEditionId"`
+	TitleSlug        string         `json:"titleSlug"`
+	Isbn13           string         `json:"isbn13"`
+	Asin             string         `json:"asin"`
+	Title            string         `json:"title"`
+	Language         string         `json:"language,omitempty"`
+	Overview         string         `json:"overview"`
+	Format           string         `json:"format"`
+	Disambiguation   string         `json:"disambiguation,omitempty"`
+	Publisher        string         `json:"publisher"`
+	PageCount        int            `json:"pageCount"`
+	ReleaseDate      time.Time      `json:"releaseDate"`
+	Images           []*starr.Image `json:"images"`
+	Links            []*starr.Link  `json:"links"`
+	Ratings          *starr.Ratings `json:"ratings"`
+	Monitored        bool           `json:"monitored"`
+	ManualAdd        bool           `json:"manualAdd"`
+	IsEbook          bool           `json:"isEbook"`
+	RemoteCover      string         `json:"remoteCover,omitempty"`
+}
+
+// GetEditions returns the editions of one or more books.
+// To pick an edition, set Monitored on it (and not the others) and pass the book to UpdateBook.
+func (r *Readarr) GetEditions(bookIDs ...int64) ([]*Edition, error) {
+	return r.GetEditionsContext(context.Background(), bookIDs...)
+}
+
+// GetEditionsContext returns the editions of one or more books.
+// To pick an edition, set Monitored on it (and not the others) and pass the book to UpdateBook.
+func (r *Readarr) GetEditionsContext(ctx context.Context, bookIDs ...int64) ([]*Edition, error) {
+	var output []*Edition
+
+	req := starr.Request{URI: bpEdition, Query: make(url.Values)}
+	for _, id := range bookIDs {
+		req.Query.Add("bookId", starr.Str(id))
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,58 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetEditions(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "edition") + "?bookId=4&bookId=5",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id":1,"bookId":4,"foreignEditionId":"123","title":"Dune","language":"eng",
+				"format":"Paperback","isEbook":false,"monitored":true},
+				{"id":2,"bookId":5,"foreignEditionId":"456","title":"Dune Messiah","isEbook":true}]`,
+			WithRequest: []int64{4, 5},
+			WithResponse: []*readarr.Edition{
+				{
+					ID: 1, BookID: 4, ForeignEditionID: "123", Title: "Dune", Language: "eng",
+					Format: "Paperback", Monitored: true,
+				},
+				{ID: 2, BookID: 5, ForeignEditionID: "456", Title: "Dune Messiah", IsEbook: true},
+			},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "edition"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    []int64(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*readarr.Edition)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetEditions(test.WithRequest.([]int64)...)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package readarr
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -9,10 +10,11 @@ import (
 	"strings"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 // This is not an /api path.
-const bpFeed = "feed/" + APIver + "/calendar/readarr.ics"
+const bpFeed = "/feed/" + APIver + "/calendar/readarr.ics"
 
 // Feed is the /feed/v1/calendar endpoint.
 type Feed struct {
@@ -25,6 +27,9 @@ type Feed struct {
 	Unmonitored bool `json:"unmonitored"`
 }
 
+// FeedEvent is one release in the calendar ICS feed.
+type FeedEvent = starrshared.FeedEvent
+
 // GetFeed returns the Calendar ICS feed file.
 func (r *Readarr) GetFeed(filter Feed) ([]byte, error) {
 	return r.GetFeedContext(context.Background(), filter)
@@ -41,7 +46,7 @@ func (r *Readarr) GetFeedContext(ctx context.Context, filter Feed) ([]byte, erro
 		"unmonitored": {strconv.FormatBool(filter.Unmonitored)},
 		"pastDays":    {strconv.Itoa(filter.PastDays)},
 		"futureDays":  {strconv.Itoa(filter.FutureDays)},
-		"tags":        {strings.Join(tags, ",")},
+		"tagList":     {strings.Join(tags, ",")},
 	}}
 
 	resp, err := r.Get(ctx, req)
@@ -57,3 +62,23 @@ func (r *Readarr) GetFeedContext(ctx context.Context, filter Feed) ([]byte, erro
 
 	return body, nil
 }
+
+// GetFeedEvents returns the Calendar ICS feed, parsed into events.
+func (r *Readarr) GetFeedEvents(filter Feed) ([]*FeedEvent, error) {
+	return r.GetFeedEventsContext(context.Background(), filter)
+}
+
+// GetFeedEventsContext returns the Calendar ICS feed, parsed into events.
+func (r *Readarr) GetFeedEventsContext(ctx context.Context, filter Feed) ([]*FeedEvent, error) {
+	body, err := r.GetFeedContext(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := starrshared.ParseFeed(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", bpFeed, err)
+	}
+
+	return events, nil
+}
@@ -54,6 +54,33 @@ type ImportListOutput struct {
 	Fields                []*starr.FieldOutput `json:"fields"`
 }
 
+// ToInput converts the output of an import list into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (l *ImportListOutput) ToInput() *ImportListInput {
+	if l == nil {
+		return nil
+	}
+
+	return &ImportListInput{
+		EnableAutomaticAdd:    l.EnableAutomaticAdd,
+		ShouldMonitorExisting: l.ShouldMonitorExisting,
+		ShouldSearch:          l.ShouldSearch,
+		ListOrder:             int(l.ListOrder), //nolint:gosec // small number.
+		ID:                    l.ID,
+		MetadataProfileID:     l.MetadataProfileID,
+		QualityProfileID:      l.QualityProfileID,
+		ListType:              l.ListType,
+		ConfigContract:        l.ConfigContract,
+		Implementation:        l.Implementation,
+		Name:                  l.Name,
+		RootFolderPath:        l.RootFolderPath,
+		ShouldMonitor:         l.ShouldMonitor,
+		MonitorNewItems:       l.MonitorNewItems,
+		Tags:                  l.Tags,
+		Fields:                starr.ToFieldInputs(l.Fields),
+	}
+}
+
 // GetImportLists returns all configured import lists.
 func (r *Readarr) GetImportLists() ([]*ImportListOutput, error) {
 	return r.GetImportListsContext(context.Background())
@@ -0,0 +1,67 @@
+package readarr
+
+import (
+	"context"
+	"net/url"
+	"path"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const bpMediaCover = APIver + "/mediacover"
+
+// MediaCover is an open media cover image download.
+type MediaCover = starrshared.MediaCover
+
+// GetAuthorMediaCover downloads an author media cover image (jpg, png, or gif).
+// The filename is from the image URL, like poster.jpg or fanart-500.jpg.
+func (r *Readarr) GetAuthorMediaCover(authorID int64, filename string) ([]byte, error) {
+	return r.GetAuthorMediaCoverContext(context.Background(), authorID, filename)
+}
+
+// GetAuthorMediaCoverContext downloads an author media cover image (jpg, png, or gif).
+// The filename is from the image URL, like poster.jpg or fanart-500.jpg.
+func (r *Readarr) GetAuthorMediaCoverContext(ctx context.Context, authorID int64, filename string) ([]byte, error) {
+	uri := path.Join(bpMediaCover, "author", starr.Str(authorID), url.PathEscape(filename))
+	return starrshared.ReadMediaCover(ctx, r.APIer, uri)
+}
+
+// OpenAuthorMediaCover opens an author media cover image; pass a previous ETag to skip an unchanged image.
+func (r *Readarr) OpenAuthorMediaCover(authorID int64, filename, etag string) (*MediaCover, error) {
+	return r.OpenAuthorMediaCoverContext(context.Background(), authorID, filename, etag)
+}
+
+// OpenAuthorMediaCoverContext opens an author media cover image; pass a previous ETag to skip an unchanged image.
+func (r *Readarr) OpenAuthorMediaCoverContext(
+	ctx context.Context, authorID int64, filename, etag string,
+) (*MediaCover, error) {
+	uri := path.Join(bpMediaCover, "author", starr.Str(authorID), url.PathEscape(filename))
+	return starrshared.OpenMediaCover(ctx, r.APIer, uri, etag)
+}
+
+// GetBookMediaCover downloads a book media cover image (jpg, png, or gif).
+// The filename is from the image URL, like poster.jpg or fanart-500.jpg.
+func (r *Readarr) GetBookMediaCover(bookID int64, filename string) ([]byte, error) {
+	return r.GetBookMediaCoverContext(context.Background(), bookID, filename)
+}
+
+// GetBookMediaCoverContext downloads a book media cover image (jpg, png, or gif).
+// The filename is from the image URL, like poster.jpg or fanart-500.jpg.
+func (r *Readarr) GetBookMediaCoverContext(ctx context.Context, bookID int64, filename string) ([]byte, error) {
+	uri := path.Join(bpMediaCover, "book", starr.Str(bookID), url.PathEscape(filename))
+	return starrshared.ReadMediaCover(ctx, r.APIer, uri)
+}
+
+// OpenBookMediaCover opens a book media cover image; pass a previous ETag to skip an unchanged image.
+func (r *Readarr) OpenBookMediaCover(bookID int64, filename, etag string) (*MediaCover, error) {
+	return r.OpenBookMediaCoverContext(context.Background(), bookID, filename, etag)
+}
+
+// OpenBookMediaCoverContext opens a book media cover image; pass a previous ETag to skip an unchanged image.
+func (r *Readarr) OpenBookMediaCoverContext(
+	ctx context.Context, bookID int64, filename, etag string,
+) (*MediaCover, error) {
+	uri := path.Join(bpMediaCover, "book", starr.Str(bookID), url.PathEscape(filename))
+	return starrshared.OpenMediaCover(ctx, r.APIer, uri, etag)
+}
@@ -0,0 +1,249 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+const metadataBody = `{
+	"enable": true,
+	"name": "Calibre",
+	"fields": [{"order": 0, "name": "bookMetadata", "label": "Book Metadata", "value": true, "type": "checkbox"}],
+	"implementationName": "Calibre",
+	"implementation": "CalibreMetadata",
+	"configContract": "CalibreMetadataSettings",
+	"infoLink": "https://wiki.servarr.com/readarr/supported#calibremetadata",
+	"tags": [1],
+	"id": 1
+}`
+
+var metadataOutput = &readarr.MetadataOutput{
+	ID:     1,
+	Enable: true,
+	Name:   "Calibre",
+	Fields: []*starr.FieldOutput{
+		{Order: 0, Name: "bookMetadata", Label: "Book Metadata", Value: true, Type: "checkbox"},
+	},
+	ImplementationName: "Calibre",
+	Implementation:     "CalibreMetadata",
+	ConfigContract:     "CalibreMetadataSettings",
+	InfoLink:           "https://wiki.servarr.com/readarr/supported#calibremetadata",
+	Tags:               []int{1},
+}
+
+func TestGetMetadata(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadata"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			ResponseBody:   "[" + metadataBody + "]",
+			WithResponse:   []*readarr.MetadataOutput{metadataOutput},
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadata"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*readarr.MetadataOutput(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMetadata()
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGetMetadataByID(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadata", "1"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    int64(1),
+			ResponseBody:   metadataBody,
+			WithResponse:   metadataOutput,
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadata", "1"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    int64(1),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   (*readarr.MetadataOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetMetadataByID(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestAddMetadata(t *testing.T) {
+	t.Parallel()
+
+	input := &readarr.MetadataInput{
+		Name:           "Calibre",
+		Fields:         []*starr.FieldInput{{Name: "bookMetadata", Value: true}},
+		Implementation: "CalibreMetadata",
+		ConfigContract: "CalibreMetadataSettings",
+		Enable:         true,
+	}
+	request := `{"name":"Calibre","fields":[{"name":"bookMetadata","value":true}],` +
+		`"implementation":"CalibreMetadata","configContract":"CalibreMetadataSettings","enable":true}` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "metadata?forceSave=false"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  200,
+			WithRequest:     input,
+			ExpectedRequest: request,
+			ResponseBody:    metadataBody,
+			WithResponse:    metadataOutput,
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "metadata?forceSave=false"),
+			ExpectedMethod:  "POST",
+			ResponseStatus:  404,
+			WithRequest:     input,
+			ExpectedRequest: request,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    (*readarr.MetadataOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.AddMetadata(test.WithRequest.(*readarr.MetadataInput), false)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestUpdateMetadata(t *testing.T) {
+	t.Parallel()
+
+	input := &readarr.MetadataInput{
+		ID:             1,
+		Name:           "Calibre",
+		Fields:         []*starr.FieldInput{{Name: "bookMetadata", Value: false}},
+		Implementation: "CalibreMetadata",
+		ConfigContract: "CalibreMetadataSettings",
+		Enable:         true,
+	}
+	request := `{"id":1,"name":"Calibre","fields":[{"name":"bookMetadata","value":false}],` +
+		`"implementation":"CalibreMetadata","configContract":"CalibreMetadataSettings","enable":true}` + "\n"
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "200",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "metadata", "1?forceSave=true"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  200,
+			WithRequest:     input,
+			ExpectedRequest: request,
+			ResponseBody:    `{"id": 1, "name": "Calibre", "enable": true}`,
+			WithResponse:    &readarr.MetadataOutput{ID: 1, Name: "Calibre", Enable: true},
+			WithError:       nil,
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "metadata", "1?forceSave=true"),
+			ExpectedMethod:  "PUT",
+			ResponseStatus:  404,
+			WithRequest:     input,
+			ExpectedRequest: request,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    (*readarr.MetadataOutput)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.UpdateMetadata(test.WithRequest.(*readarr.MetadataInput), true)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteMetadata(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadata", "1"),
+			ExpectedMethod: "DELETE",
+			ResponseStatus: 200,
+			WithRequest:    int64(1),
+			ResponseBody:   "{}",
+			WithError:      nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "metadata", "1"),
+			ExpectedMethod: "DELETE",
+			ResponseStatus: 404,
+			WithRequest:    int64(1),
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			err := client.DeleteMetadata(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+		})
+	}
+}
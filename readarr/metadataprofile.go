@@ -1,8 +1,11 @@
 package readarr
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"path"
 
 	"golift.io/starr"
 )
@@ -11,14 +14,16 @@ const bpMetadataProfile = APIver + "/metadataprofile"
 
 // MetadataProfile is the /api/v1/metadataProfile endpoint.
 type MetadataProfile struct {
-	ID                  int64   `json:"id"`
-	Name                string  `json:"name"`
-	MinPopularity       float64 `json:"minPopularity"`
-	SkipMissingDate     bool    `json:"skipMissingDate"`
-	SkipMissingIsbn     bool    `json:"skipMissingIsbn"`
-	SkipPartsAndSets    bool    `json:"skipPartsAndSets"`
-	SkipSeriesSecondary bool    `json:"skipSeriesSecondary"`
-	AllowedLanguages    string  `json:"allowedLanguages,omitempty"`
+	ID                  int64    `json:"id"`
+	Name                string   `json:"name"`
+	MinPopularity       float64  `json:"minPopularity"`
+	SkipMissingDate     bool     `json:"skipMissingDate"`
+	SkipMissingIsbn     bool     `json:"skipMissingIsbn"`
+	SkipPartsAndSets    bool     `json:"skipPartsAndSets"`
+	SkipSeriesSecondary bool     `json:"skipSeriesSecondary"`
+	AllowedLanguages    string   `json:"allowedLanguages,omitempty"` // Comma separated ISO 639-2 codes.
+	MinPages            int      `json:"minPages"`
+	Ignored             []string `json:"ignored,omitempty"` // Terms to skip books by.
 }
 
 // GetMetadataProfiles returns the metadata profiles.
@@ -37,3 +42,100 @@ func (r *Readarr) GetMetadataProfilesContext(ctx context.Context) ([]*MetadataPr
 
 	return output, nil
 }
+
+// GetMetadataProfile returns a single metadata profile.
+func (r *Readarr) GetMetadataProfile(profileID int64) (*MetadataProfile, error) {
+	return r.GetMetadataProfileContext(context.Background(), profileID)
+}
+
+// GetMetadataProfileContext returns a single metadata profile.
+func (r *Readarr) GetMetadataProfileContext(ctx context.Context, profileID int64) (*MetadataProfile, error) {
+	var output MetadataProfile
+
+	req := starr.Request{URI: path.Join(bpMetadataProfile, starr.Str(profileID))}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// GetMetadataProfileSchema returns a new metadata profile with the default settings.
+func (r *Readarr) GetMetadataProfileSchema() (*MetadataProfile, error) {
+	return r.GetMetadataProfileSchemaContext(context.Background())
+}
+
+// GetMetadataProfileSchemaContext returns a new metadata profile with the default settings.
+func (r *Readarr) GetMetadataProfileSchemaContext(ctx context.Context) (*MetadataProfile, error) {
+	var output MetadataProfile
+
+	req := starr.Request{URI: path.Join(bpMetadataProfile, "schema")}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// AddMetadataProfile creates a metadata profile.
+func (r *Readarr) AddMetadataProfile(profile *MetadataProfile) (*MetadataProfile, error) {
+	return r.AddMetadataProfileContext(context.Background(), profile)
+}
+
+// AddMetadataProfileContext creates a metadata profile.
+func (r *Readarr) AddMetadataProfileContext(ctx context.Context, profile *MetadataProfile) (*MetadataProfile, error) {
+	var output MetadataProfile
+
+	profile.ID = 0
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(profile); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMetadataProfile, err)
+	}
+
+	req := starr.Request{URI: bpMetadataProfile, Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// UpdateMetadataProfile updates a metadata profile.
+func (r *Readarr) UpdateMetadataProfile(profile *MetadataProfile) (*MetadataProfile, error) {
+	return r.UpdateMetadataProfileContext(context.Background(), profile)
+}
+
+// UpdateMetadataProfileContext updates a metadata profile.
+func (r *Readarr) UpdateMetadataProfileContext(
+	ctx context.Context, profile *MetadataProfile,
+) (*MetadataProfile, error) {
+	var output MetadataProfile
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(profile); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpMetadataProfile, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpMetadataProfile, starr.Str(profile.ID)), Body: &body}
+	if err := r.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteMetadataProfile deletes a metadata profile.
+func (r *Readarr) DeleteMetadataProfile(profileID int64) error {
+	return r.DeleteMetadataProfileContext(context.Background(), profileID)
+}
+
+// DeleteMetadataProfileContext deletes a metadata profile.
+func (r *Readarr) DeleteMetadataProfileContext(ctx context.Context, profileID int64) error {
+	req := starr.Request{URI: path.Join(bpMetadataProfile, starr.Str(profileID))}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
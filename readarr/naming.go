@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"path"
 
 	"golift.io/starr"
 )
@@ -79,3 +81,46 @@ func (r *Readarr) UpdateNamingContext(ctx context.Context, naming *Naming) (*Nam
 
 	return &output, nil
 }
+
+// NamingExamples are the file and folder names rendered from a naming config by PreviewNaming.
+// An example is empty when its format is empty or invalid.
+type NamingExamples struct {
+	SingleBookExample   string `json:"singleBookExample"`
+	AuthorFolderExample string `json:"authorFolderExample"`
+}
+
+// PreviewNaming renders example file and folder names from a naming config, without saving it.
+func (r *Readarr) PreviewNaming(naming *Naming) (*NamingExamples, error) {
+	return r.PreviewNamingContext(context.Background(), naming)
+}
+
+// PreviewNamingContext renders example file and folder names from a naming config, without saving it.
+func (r *Readarr) PreviewNamingContext(ctx context.Context, naming *Naming) (*NamingExamples, error) {
+	var output NamingExamples
+
+	req := starr.Request{URI: path.Join(bpNaming, "examples"), Query: naming.values()}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// values turns a naming config into the query parameters for the examples endpoint.
+func (n *Naming) values() url.Values {
+	if n == nil {
+		n = &Naming{}
+	}
+
+	return url.Values{
+		"renameBooks":              {starr.Str(n.RenameBooks)},
+		"replaceIllegalCharacters": {starr.Str(n.ReplaceIllegalCharacters)},
+		"colonReplacementFormat":   {starr.Str(int(n.ColonReplacementFormat))},
+		"standardBookFormat":       {n.StandardBookFormat},
+		"authorFolderFormat":       {n.AuthorFolderFormat},
+		"includeAuthorName":        {starr.Str(n.IncludeAuthorName)},
+		"includeBookTitle":         {starr.Str(n.IncludeBookTitle)},
+		"includeQuality":           {starr.Str(n.IncludeQuality)},
+		"replaceSpaces":            {starr.Str(n.ReplaceSpaces)},
+	}
+}
@@ -0,0 +1,44 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestPreviewNaming(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath: path.Join("/", starr.API, readarr.APIver, "config", "naming", "examples") +
+			"?authorFolderFormat=%7BAuthor+Name%7D&colonReplacementFormat=1&includeAuthorName=false" +
+			"&includeBookTitle=false&includeQuality=true&renameBooks=true&replaceIllegalCharacters=true" +
+			"&replaceSpaces=false&standardBookFormat=%7BBook+Title%7D",
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `{"singleBookExample":"The Book Title","authorFolderExample":"The Author Name"}`,
+		WithRequest: &readarr.Naming{
+			RenameBooks:              true,
+			ReplaceIllegalCharacters: true,
+			IncludeQuality:           true,
+			ColonReplacementFormat:   readarr.ColonReplaceWithDash,
+			StandardBookFormat:       "{Book Title}",
+			AuthorFolderFormat:       "{Author Name}",
+		},
+		WithResponse: &readarr.NamingExamples{
+			SingleBookExample:   "The Book Title",
+			AuthorFolderExample: "The Author Name",
+		},
+	}
+
+	client := readarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	output, err := client.PreviewNaming(test.WithRequest.(*readarr.Naming))
+	require.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+}
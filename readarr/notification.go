@@ -76,6 +76,37 @@ type NotificationOutput struct {
 	Fields                             []*starr.FieldOutput `json:"fields"`
 }
 
+// ToInput converts the output of a notification into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (n *NotificationOutput) ToInput() *NotificationInput {
+	if n == nil {
+		return nil
+	}
+
+	return &NotificationInput{
+		OnGrab:                     n.OnGrab,
+		OnReleaseImport:            n.OnReleaseImport,
+		OnUpgrade:                  n.OnUpgrade,
+		OnRename:                   n.OnRename,
+		OnAuthorDelete:             n.OnAuthorDelete,
+		OnBookDelete:               n.OnBookDelete,
+		OnBookFileDelete:           n.OnBookFileDelete,
+		OnBookFileDeleteForUpgrade: n.OnBookFileDeleteForUpgrade,
+		OnHealthIssue:              n.OnHealthIssue,
+		OnDownloadFailure:          n.OnDownloadFailure,
+		OnImportFailure:            n.OnImportFailure,
+		OnBookRetag:                n.OnBookRetag,
+		OnApplicationUpdate:        n.OnApplicationUpdate,
+		IncludeHealthWarnings:      n.IncludeHealthWarnings,
+		ID:                         n.ID,
+		Name:                       n.Name,
+		Implementation:             n.Implementation,
+		ConfigContract:             n.ConfigContract,
+		Tags:                       n.Tags,
+		Fields:                     starr.ToFieldInputs(n.Fields),
+	}
+}
+
 // GetNotifications returns all configured notifications.
 func (r *Readarr) GetNotifications() ([]*NotificationOutput, error) {
 	return r.GetNotificationsContext(context.Background())
@@ -5,10 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"path"
 	"time"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpQueue = APIver + "/queue"
@@ -48,6 +50,9 @@ type QueueRecord struct {
 	ErrorMessage            string                 `json:"errorMessage"`
 }
 
+// QueueStatus is the aggregate queue status from /api/v1/queue/status.
+type QueueStatus = starrshared.QueueStatus
+
 // GetQueue returns a single page from the Readarr Queue (processing, but not yet imported).
 // If you need control over the page, use readarr.GetQueuePage().
 // This function simply returns the number of queue records desired,
@@ -153,3 +158,90 @@ func (r *Readarr) QueueGrabContext(ctx context.Context, ids ...int64) error {
 
 	return nil
 }
+
+// GetQueueRecords returns the queue records without paging, optionally filtered.
+// Pass a zero authorID and no bookIDs to return the whole queue.
+func (r *Readarr) GetQueueRecords(authorID int64, bookIDs ...int64) ([]*QueueRecord, error) {
+	return r.GetQueueRecordsContext(context.Background(), authorID, bookIDs...)
+}
+
+// GetQueueRecordsContext returns the queue records without paging, optionally filtered.
+// Pass a zero authorID and no bookIDs to return the whole queue.
+func (r *Readarr) GetQueueRecordsContext(
+	ctx context.Context, authorID int64, bookIDs ...int64,
+) ([]*QueueRecord, error) {
+	var output []*QueueRecord
+
+	req := starr.Request{URI: path.Join(bpQueue, "details"), Query: make(url.Values)}
+	if authorID != 0 {
+		req.Query.Set("authorId", starr.Str(authorID))
+	}
+
+	for _, id := range bookIDs {
+		req.Query.Add("bookIds", starr.Str(id))
+	}
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetQueueStatus returns aggregate queue status.
+func (r *Readarr) GetQueueStatus() (*QueueStatus, error) {
+	return r.GetQueueStatusContext(context.Background())
+}
+
+// GetQueueStatusContext returns aggregate queue status.
+func (r *Readarr) GetQueueStatusContext(ctx context.Context) (*QueueStatus, error) {
+	var output QueueStatus
+
+	req := starr.Request{URI: path.Join(bpQueue, "status")}
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// DeleteQueueBulk removes multiple items from the Activity Queue in one request.
+// The options apply to every item, the same as DeleteQueue.
+func (r *Readarr) DeleteQueueBulk(ids []int64, opts *starr.QueueDeleteOpts) error {
+	return r.DeleteQueueBulkContext(context.Background(), ids, opts)
+}
+
+// DeleteQueueBulkContext removes multiple items from the Activity Queue in one request.
+// The options apply to every item, the same as DeleteQueue.
+func (r *Readarr) DeleteQueueBulkContext(ctx context.Context, ids []int64, opts *starr.QueueDeleteOpts) error {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(struct {
+		IDs []int64 `json:"ids"`
+	}{IDs: ids}); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpQueue, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpQueue, "bulk"), Body: &body, Query: opts.Values()}
+	if err := r.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// QueueGrabOne tells Readarr to grab a single delayed queue item.
+func (r *Readarr) QueueGrabOne(queueID int64) error {
+	return r.QueueGrabOneContext(context.Background(), queueID)
+}
+
+// QueueGrabOneContext tells Readarr to grab a single delayed queue item.
+func (r *Readarr) QueueGrabOneContext(ctx context.Context, queueID int64) error {
+	var output any // any ok
+
+	req := starr.Request{URI: path.Join(bpQueue, "grab", starr.Str(queueID))}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return nil
+}
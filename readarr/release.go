@@ -0,0 +1,161 @@
+package readarr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"golift.io/starr"
+	"golift.io/starr/starrshared"
+)
+
+const (
+	bpRelease     = APIver + "/release"
+	bpReleasePush = bpRelease + "/push"
+)
+
+// Release is the output from the Readarr release endpoint.
+type Release struct {
+	ID                  int64                 `json:"id"`
+	GUID                string                `json:"guid"`
+	Quality             *starr.Quality        `json:"quality"`
+	QualityWeight       int64                 `json:"qualityWeight"`
+	Age                 int64                 `json:"age"`
+	AgeHours            float64               `json:"ageHours"`
+	AgeMinutes          float64               `json:"ageMinutes"`
+	Size                int64                 `json:"size"`
+	IndexerID           int64                 `json:"indexerId"`
+	Indexer             string                `json:"indexer"`
+	ReleaseGroup        string                `json:"releaseGroup"`
+	SubGroup            string                `json:"subGroup"`
+	ReleaseHash         string                `json:"releaseHash"`
+	Title               string                `json:"title"`
+	Discography         bool                  `json:"discography"`
+	SceneSource         bool                  `json:"sceneSource"`
+	AirDate             string                `json:"airDate"`
+	AuthorName          string                `json:"authorName"`
+	BookTitle           string                `json:"bookTitle"`
+	Approved            bool                  `json:"approved"`
+	TemporarilyRejected bool                  `json:"temporarilyRejected"`
+	Rejected            bool                  `json:"rejected"`
+	Rejections          []string              `json:"rejections"`
+	PublishDate         time.Time             `json:"publishDate"`
+	CommentURL          string                `json:"commentUrl"`
+	DownloadURL         string                `json:"downloadUrl"`
+	InfoURL             string                `json:"infoUrl"`
+	DownloadAllowed     bool                  `json:"downloadAllowed"`
+	ReleaseWeight       int64                 `json:"releaseWeight"`
+	CustomFormats       []*CustomFormatOutput `json:"customFormats"`
+	CustomFormatScore   int64                 `json:"customFormatScore"`
+	MagnetURL           string                `json:"magnetUrl"`
+	InfoHash            string                `json:"infoHash"`
+	Seeders             int                   `json:"seeders"`
+	Leechers            int                   `json:"leechers"`
+	Protocol            starr.Protocol        `json:"protocol"`
+	IndexerFlags        int64                 `json:"indexerFlags,omitempty"`
+	AuthorID            int64                 `json:"authorId"`
+	BookID              int64                 `json:"bookId"`
+	DownloadClientID    int64                 `json:"downloadClientId"`
+	DownloadClient      string                `json:"downloadClient"`
+}
+
+// SearchRelease is the input needed to search for releases through Readarr.
+// Set BookID to search for one book, or AuthorID to search for all of an author's books.
+type SearchRelease struct {
+	BookID   int64 `json:"bookId"`
+	AuthorID int64 `json:"authorId"`
+}
+
+// SearchRelease searches for and returns a list releases available for download.
+func (r *Readarr) SearchRelease(input *SearchRelease) ([]*Release, error) {
+	return r.SearchReleaseContext(context.Background(), input)
+}
+
+// SearchReleaseContext searches for and returns a list releases available for download.
+func (r *Readarr) SearchReleaseContext(ctx context.Context, input *SearchRelease) ([]*Release, error) {
+	req := starr.Request{URI: bpRelease, Query: make(url.Values)}
+
+	if input.BookID != 0 {
+		req.Query.Set("bookId", starr.Str(input.BookID))
+	}
+
+	if input.AuthorID != 0 {
+		req.Query.Set("authorId", starr.Str(input.AuthorID))
+	}
+
+	var output []*Release
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// Grab adds a release and attempts to download it. Use this with Pr*wlarr search output.
+func (r *Readarr) Grab(guid string, indexerID int64) (*Release, error) {
+	return r.GrabContext(context.Background(), guid, indexerID)
+}
+
+// GrabContext adds a release and attempts to download it. Use this with Pr*wlarr search output.
+func (r *Readarr) GrabContext(ctx context.Context, guid string, indexerID int64) (*Release, error) {
+	return r.GrabReleaseContext(ctx, &Release{IndexerID: indexerID, GUID: guid})
+}
+
+// GrabRelease adds a release and attempts to download it.
+// Pass the release for the item from the SearchRelease output.
+func (r *Readarr) GrabRelease(release *Release) (*Release, error) {
+	return r.GrabReleaseContext(context.Background(), release)
+}
+
+// GrabReleaseContext adds a release and attempts to download it.
+// Pass the release for the item from the SearchRelease output.
+func (r *Readarr) GrabReleaseContext(ctx context.Context, release *Release) (*Release, error) {
+	grab := struct { // We only use/need the guid and indexerID from the release.
+		G string `json:"guid"`
+		I int64  `json:"indexerId"`
+	}{G: release.GUID, I: release.IndexerID}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&grab); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpRelease, err)
+	}
+
+	var output Release
+
+	req := starr.Request{URI: bpRelease, Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// PushRelease is the /api/v1/release/push resource.
+type PushRelease = starrshared.PushRelease
+
+// PushRelease sends a release to Readarr. The output has Readarr's decision:
+// Approved is true if the release was grabbed, and Rejections explains why it was not.
+func (r *Readarr) PushRelease(push *PushRelease) (*Release, error) {
+	return r.PushReleaseContext(context.Background(), push)
+}
+
+// PushReleaseContext sends a release to Readarr. The output has Readarr's decision:
+// Approved is true if the release was grabbed, and Rejections explains why it was not.
+func (r *Readarr) PushReleaseContext(ctx context.Context, push *PushRelease) (*Release, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(push); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", bpReleasePush, err)
+	}
+
+	var output Release
+
+	req := starr.Request{URI: bpReleasePush, Body: &body}
+	if err := r.PostInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Post(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
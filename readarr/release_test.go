@@ -0,0 +1,130 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+const releaseResponseBody = `{
+    "guid": "abc123",
+    "quality": {"quality": {"id": 6, "name": "EPUB"}, "revision": {"version": 1, "real": 0}},
+    "size": 512000000,
+    "indexerId": 2,
+    "indexer": "MAM",
+    "title": "Author - Book (2020) [EPUB]",
+    "authorName": "Author",
+    "bookTitle": "Book",
+    "approved": true,
+    "rejections": [],
+    "customFormats": [{"id": 3, "name": "Retail"}],
+    "protocol": "torrent",
+    "authorId": 4,
+    "bookId": 5
+}`
+
+func TestSearchRelease(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "release") + "?bookId=5",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			WithRequest:    &readarr.SearchRelease{BookID: 5},
+			ResponseBody:   "[" + releaseResponseBody + "]",
+			WithResponse: []*readarr.Release{{
+				GUID: "abc123",
+				Quality: &starr.Quality{
+					Quality:  &starr.BaseQuality{ID: 6, Name: "EPUB"},
+					Revision: &starr.QualityRevision{Version: 1},
+				},
+				Size:          512000000,
+				IndexerID:     2,
+				Indexer:       "MAM",
+				Title:         "Author - Book (2020) [EPUB]",
+				AuthorName:    "Author",
+				BookTitle:     "Book",
+				Approved:      true,
+				Rejections:    []string{},
+				CustomFormats: []*readarr.CustomFormatOutput{{ID: 3, Name: "Retail"}},
+				Protocol:      starr.ProtocolTorrent,
+				AuthorID:      4,
+				BookID:        5,
+			}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "release") + "?authorId=4",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			WithRequest:    &readarr.SearchRelease{AuthorID: 4},
+			ResponseBody:   starrtest.BodyNotFound,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*readarr.Release(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.SearchRelease(test.WithRequest.(*readarr.SearchRelease))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestGrabRelease(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:    path.Join("/", starr.API, readarr.APIver, "release"),
+		ExpectedMethod:  http.MethodPost,
+		ExpectedRequest: `{"guid":"abc123","indexerId":2}` + "\n",
+		ResponseStatus:  http.StatusOK,
+		ResponseBody:    `{"guid":"abc123","indexerId":2}`,
+	}
+	mockServer := test.GetMockServer(t)
+	client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+
+	output, err := client.GrabRelease(&readarr.Release{GUID: "abc123", IndexerID: 2, Title: "ignored"})
+	require.NoError(t, err)
+	assert.Equal(t, &readarr.Release{GUID: "abc123", IndexerID: 2}, output)
+}
+
+func TestPushRelease(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "release", "push"),
+		ExpectedMethod: http.MethodPost,
+		ExpectedRequest: `{"title":"Author - Book (2020) [EPUB]","downloadUrl":"http://indexer/get/1",` +
+			`"protocol":"torrent","publishDate":"2020-01-01T00:00:00Z","indexer":"MAM"}` + "\n",
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `{"title":"Author - Book (2020) [EPUB]","approved":false,"rejections":["Unknown Author"]}`,
+	}
+	mockServer := test.GetMockServer(t)
+	client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+
+	output, err := client.PushRelease(&readarr.PushRelease{
+		Title:       "Author - Book (2020) [EPUB]",
+		DownloadURL: "http://indexer/get/1",
+		Protocol:    starr.ProtocolTorrent,
+		PublishDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Indexer:     "MAM",
+	})
+	require.NoError(t, err)
+	assert.False(t, output.Approved)
+	assert.Equal(t, []string{"Unknown Author"}, output.Rejections)
+}
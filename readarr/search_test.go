@@ -216,6 +216,7 @@ var testEdition = readarr.Edition{
 	Isbn13:           "123456789012",
 	Asin:             "123456789X",
 	Title:            "Book",
+	Language:         "eng",
 	Overview:         "book overview",
 	Format:           "Hardcover",
 	IsEbook:          false,
@@ -0,0 +1,47 @@
+package readarr
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"golift.io/starr"
+)
+
+const bpSeries = APIver + "/series"
+
+// Series is the /api/v1/series endpoint. A book series, like a trilogy.
+type Series struct {
+	ID          int64             `json:"id"`
+	Title       string            `json:"title"`
+	Description string            `json:"description"`
+	Links       []*SeriesBookLink `json:"links"`
+}
+
+// SeriesBookLink puts a book in a Series.
+type SeriesBookLink struct {
+	ID             int64  `json:"id"`
+	Position       string `json:"position"` // Not always a number, like "1.5" or "1-3".
+	SeriesPosition int    `json:"seriesPosition"`
+	SeriesID       int64  `json:"seriesId"`
+	BookID         int64  `json:"bookId"`
+}
+
+// GetSeries returns the book series that an author's books belong to.
+func (r *Readarr) GetSeries(authorID int64) ([]*Series, error) {
+	return r.GetSeriesContext(context.Background(), authorID)
+}
+
+// GetSeriesContext returns the book series that an author's books belong to.
+func (r *Readarr) GetSeriesContext(ctx context.Context, authorID int64) ([]*Series, error) {
+	var output []*Series
+
+	req := starr.Request{URI: bpSeries, Query: make(url.Values)}
+	req.Query.Set("authorId", starr.Str(authorID))
+
+	if err := r.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
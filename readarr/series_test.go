@@ -0,0 +1,54 @@
+package readarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetSeries(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "series") + "?authorId=2",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody: `[{"id":3,"title":"Dune","description":"Arrakis","links":[
+				{"id":9,"position":"1.5","seriesPosition":2,"seriesId":3,"bookId":7}]}]`,
+			WithRequest: int64(2),
+			WithResponse: []*readarr.Series{{
+				ID: 3, Title: "Dune", Description: "Arrakis",
+				Links: []*readarr.SeriesBookLink{{ID: 9, Position: "1.5", SeriesPosition: 2, SeriesID: 3, BookID: 7}},
+			}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, readarr.APIver, "series") + "?authorId=2",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    int64(2),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*readarr.Series)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := readarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetSeries(test.WithRequest.(int64))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
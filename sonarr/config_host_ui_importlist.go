@@ -8,6 +8,7 @@ import (
 	"path"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const (
@@ -17,60 +18,10 @@ const (
 )
 
 // HostConfig is the /api/v3/config/host resource.
-type HostConfig struct {
-	ID                        int    `json:"id,omitempty"`
-	BindAddress               string `json:"bindAddress,omitempty"`
-	Port                      int    `json:"port"`
-	SSLPort                   int    `json:"sslPort"`
-	EnableSSL                 bool   `json:"enableSsl"`
-	LaunchBrowser             bool   `json:"launchBrowser"`
-	AuthenticationMethod      string `json:"authenticationMethod,omitempty"`
-	AuthenticationRequired    string `json:"authenticationRequired,omitempty"`
-	AnalyticsEnabled          bool   `json:"analyticsEnabled"`
-	Username                  string `json:"username,omitempty"`
-	Password                  string `json:"password,omitempty"`
-	PasswordConfirmation      string `json:"passwordConfirmation,omitempty"`
-	LogLevel                  string `json:"logLevel,omitempty"`
-	LogSizeLimit              int    `json:"logSizeLimit"`
-	ConsoleLogLevel           string `json:"consoleLogLevel,omitempty"`
-	Branch                    string `json:"branch,omitempty"`
-	APIKey                    string `json:"apiKey,omitempty"`
-	SSLCertPath               string `json:"sslCertPath,omitempty"`
-	SSLCertPassword           string `json:"sslCertPassword,omitempty"`
-	URLBase                   string `json:"urlBase,omitempty"`
-	InstanceName              string `json:"instanceName,omitempty"`
-	ApplicationURL            string `json:"applicationUrl,omitempty"`
-	UpdateAutomatically       bool   `json:"updateAutomatically"`
-	UpdateMechanism           string `json:"updateMechanism,omitempty"`
-	UpdateScriptPath          string `json:"updateScriptPath,omitempty"`
-	ProxyEnabled              bool   `json:"proxyEnabled"`
-	ProxyType                 string `json:"proxyType,omitempty"`
-	ProxyHostname             string `json:"proxyHostname,omitempty"`
-	ProxyPort                 int    `json:"proxyPort"`
-	ProxyUsername             string `json:"proxyUsername,omitempty"`
-	ProxyPassword             string `json:"proxyPassword,omitempty"`
-	ProxyBypassFilter         string `json:"proxyBypassFilter,omitempty"`
-	ProxyBypassLocalAddresses bool   `json:"proxyBypassLocalAddresses"`
-	CertificateValidation     string `json:"certificateValidation,omitempty"`
-	BackupFolder              string `json:"backupFolder,omitempty"`
-	BackupInterval            int    `json:"backupInterval"`
-	BackupRetention           int    `json:"backupRetention"`
-	TrustCgnatIPAddresses     bool   `json:"trustCgnatIpAddresses"`
-}
+type HostConfig = starrshared.HostConfig
 
 // UIConfig is the /api/v3/config/ui resource.
-type UIConfig struct {
-	ID                       int    `json:"id,omitempty"`
-	FirstDayOfWeek           int    `json:"firstDayOfWeek"`
-	CalendarWeekColumnHeader string `json:"calendarWeekColumnHeader,omitempty"`
-	ShortDateFormat          string `json:"shortDateFormat,omitempty"`
-	LongDateFormat           string `json:"longDateFormat,omitempty"`
-	TimeFormat               string `json:"timeFormat,omitempty"`
-	ShowRelativeDates        bool   `json:"showRelativeDates"`
-	EnableColorImpairedMode  bool   `json:"enableColorImpairedMode"`
-	Theme                    string `json:"theme,omitempty"`
-	UILanguage               int    `json:"uiLanguage"`
-}
+type UIConfig = starrshared.UIConfig
 
 // ImportListConfig is the /api/v3/config/importlist resource.
 type ImportListConfig struct {
@@ -502,3 +502,22 @@ func TestDeleteDownloadClient(t *testing.T) {
 		})
 	}
 }
+
+func TestOutputToInput(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]func(*testing.T){
+		"CustomFormat":   starrtest.ToInputCase((*sonarr.CustomFormatOutput).ToInput, customFormatResponseBody),
+		"DownloadClient": starrtest.ToInputCase((*sonarr.DownloadClientOutput).ToInput, downloadClientResponseBody),
+		"ImportList":     starrtest.ToInputCase((*sonarr.ImportListOutput).ToInput, importListResponseBody),
+		"Indexer":        starrtest.ToInputCase((*sonarr.IndexerOutput).ToInput, indexerResponseBody),
+		"Notification":   starrtest.ToInputCase((*sonarr.NotificationOutput).ToInput, notificationResponseBody),
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			test(t)
+		})
+	}
+}
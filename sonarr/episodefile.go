@@ -14,6 +14,16 @@ import (
 
 const bpEpisodeFile = APIver + "/episodeFile"
 
+// EpisodeFileEditorInput is the input for EditEpisodeFiles (PUT /episodeFile/editor).
+// Every episode file in EpisodeFileIDs gets the same values. Nil members are not changed.
+type EpisodeFileEditorInput struct {
+	EpisodeFileIDs []int64        `json:"episodeFileIds"`
+	Languages      []*starr.Value `json:"languages,omitempty"` // v4 only
+	Quality        *starr.Quality `json:"quality,omitempty"`
+	SceneName      *string        `json:"sceneName,omitempty"`
+	ReleaseGroup   *string        `json:"releaseGroup,omitempty"`
+}
+
 // EpisodeFile is the output from the /api/v3/episodeFile endpoint.
 type EpisodeFile struct {
 	ID                   int64                 `json:"id"`
@@ -26,6 +36,7 @@ type EpisodeFile struct {
 	SceneName            string                `json:"sceneName"`
 	ReleaseGroup         string                `json:"releaseGroup"`
 	Language             *starr.Value          `json:"language"`
+	Languages            []*starr.Value        `json:"languages,omitempty"` // v4 only
 	Quality              *starr.Quality        `json:"quality"`
 	MediaInfo            *MediaInfo            `json:"mediaInfo"`
 	QualityCutoffNotMet  bool                  `json:"qualityCutoffNotMet"`
@@ -134,3 +145,71 @@ func (s *Sonarr) DeleteEpisodeFileContext(ctx context.Context, episodeFileID int
 
 	return nil
 }
+
+// EditEpisodeFiles applies bulk edits to episode files. Nil input members are not changed.
+func (s *Sonarr) EditEpisodeFiles(in *EpisodeFileEditorInput) ([]*EpisodeFile, error) {
+	return s.EditEpisodeFilesContext(context.Background(), in)
+}
+
+// EditEpisodeFilesContext applies bulk edits to episode files. Nil input members are not changed.
+func (s *Sonarr) EditEpisodeFilesContext(ctx context.Context, in *EpisodeFileEditorInput) ([]*EpisodeFile, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(in); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", path.Join(bpEpisodeFile, "editor"), err)
+	}
+
+	var output []*EpisodeFile
+
+	req := starr.Request{URI: path.Join(bpEpisodeFile, "editor"), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// UpdateEpisodeFiles updates many episode files in one request. Use this to set different values on each file.
+func (s *Sonarr) UpdateEpisodeFiles(files []*EpisodeFile) ([]*EpisodeFile, error) {
+	return s.UpdateEpisodeFilesContext(context.Background(), files)
+}
+
+// UpdateEpisodeFilesContext updates many episode files in one request. Use this to set different values on each file.
+func (s *Sonarr) UpdateEpisodeFilesContext(ctx context.Context, files []*EpisodeFile) ([]*EpisodeFile, error) {
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(files); err != nil {
+		return nil, fmt.Errorf("json.Marshal(%s): %w", path.Join(bpEpisodeFile, "bulk"), err)
+	}
+
+	var output []*EpisodeFile
+
+	req := starr.Request{URI: path.Join(bpEpisodeFile, "bulk"), Body: &body}
+	if err := s.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// DeleteEpisodeFiles bulk deletes episode files by their IDs.
+func (s *Sonarr) DeleteEpisodeFiles(episodeFileIDs ...int64) error {
+	return s.DeleteEpisodeFilesContext(context.Background(), episodeFileIDs...)
+}
+
+// DeleteEpisodeFilesContext bulk deletes episode files by their IDs.
+func (s *Sonarr) DeleteEpisodeFilesContext(ctx context.Context, episodeFileIDs ...int64) error {
+	postData := struct {
+		T []int64 `json:"episodeFileIds"`
+	}{episodeFileIDs}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&postData); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", bpEpisodeFile, err)
+	}
+
+	req := starr.Request{URI: path.Join(bpEpisodeFile, "bulk"), Body: &body}
+	if err := s.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestEditEpisodeFiles(t *testing.T) {
+	t.Parallel()
+
+	group := "NTb"
+	input := &sonarr.EpisodeFileEditorInput{EpisodeFileIDs: []int64{11, 12}, ReleaseGroup: &group}
+
+	tests := []*starrtest.MockData{
+		{
+			Name:            "202",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "episodeFile", "editor"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"episodeFileIds":[11,12],"releaseGroup":"NTb"}` + "\n",
+			ResponseStatus:  http.StatusAccepted,
+			ResponseBody: `[{"id":11,"seriesId":3,"seasonNumber":1,"releaseGroup":"NTb"},
+				{"id":12,"seriesId":3,"seasonNumber":1,"releaseGroup":"NTb"}]`,
+			WithResponse: []*sonarr.EpisodeFile{
+				{ID: 11, SeriesID: 3, SeasonNumber: 1, ReleaseGroup: "NTb"},
+				{ID: 12, SeriesID: 3, SeasonNumber: 1, ReleaseGroup: "NTb"},
+			},
+		},
+		{
+			Name:            "404",
+			ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "episodeFile", "editor"),
+			ExpectedMethod:  http.MethodPut,
+			ExpectedRequest: `{"episodeFileIds":[11,12],"releaseGroup":"NTb"}` + "\n",
+			ResponseStatus:  http.StatusNotFound,
+			ResponseBody:    `{"message": "NotFound"}`,
+			WithError:       &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:    ([]*sonarr.EpisodeFile)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.EditEpisodeFiles(input)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestDeleteEpisodeFiles(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:    path.Join("/", starr.API, sonarr.APIver, "episodeFile", "bulk"),
+		ExpectedMethod:  http.MethodDelete,
+		ExpectedRequest: `{"episodeFileIds":[11,12]}` + "\n",
+		ResponseStatus:  http.StatusOK,
+		ResponseBody:    `{}`,
+	}
+
+	client := sonarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	require.NoError(t, client.DeleteEpisodeFiles(11, 12))
+}
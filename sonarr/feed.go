@@ -1,6 +1,7 @@
 package sonarr
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -9,10 +10,11 @@ import (
 	"strings"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 // This is not an /api path.
-const bpFeed = "feed/" + APIver + "/calendar/sonarr.ics"
+const bpFeed = "/feed/" + APIver + "/calendar/sonarr.ics"
 
 // Feed is the /feed/v3/calendar endpoint.
 type Feed struct {
@@ -29,6 +31,9 @@ type Feed struct {
 	AsAllDay bool `json:"asAllDay"`
 }
 
+// FeedEvent is one release in the calendar ICS feed.
+type FeedEvent = starrshared.FeedEvent
+
 // GetFeed returns the Calendar ICS feed file.
 func (r *Sonarr) GetFeed(filter Feed) ([]byte, error) {
 	return r.GetFeedContext(context.Background(), filter)
@@ -63,3 +68,23 @@ func (r *Sonarr) GetFeedContext(ctx context.Context, filter Feed) ([]byte, error
 
 	return body, nil
 }
+
+// GetFeedEvents returns the Calendar ICS feed, parsed into events.
+func (r *Sonarr) GetFeedEvents(filter Feed) ([]*FeedEvent, error) {
+	return r.GetFeedEventsContext(context.Background(), filter)
+}
+
+// GetFeedEventsContext returns the Calendar ICS feed, parsed into events.
+func (r *Sonarr) GetFeedEventsContext(ctx context.Context, filter Feed) ([]*FeedEvent, error) {
+	body, err := r.GetFeedContext(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := starrshared.ParseFeed(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", bpFeed, err)
+	}
+
+	return events, nil
+}
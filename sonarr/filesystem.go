@@ -8,33 +8,13 @@ import (
 	"path"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpFilesystem = APIver + "/filesystem"
 
 // FilesystemQuery is the query for /api/v3/filesystem.
-type FilesystemQuery struct {
-	Path                               string
-	IncludeFiles                       bool
-	AllowFoldersWithoutTrailingSlashes bool
-}
-
-// Values builds query parameters for the filesystem browser.
-func (q *FilesystemQuery) Values() url.Values {
-	val := make(url.Values)
-	if q == nil {
-		return val
-	}
-
-	if q.Path != "" {
-		val.Set("path", q.Path)
-	}
-
-	val.Set("includeFiles", starr.Str(q.IncludeFiles))
-	val.Set("allowFoldersWithoutTrailingSlashes", starr.Str(q.AllowFoldersWithoutTrailingSlashes))
-
-	return val
-}
+type FilesystemQuery = starrshared.FilesystemQuery
 
 // BrowseFilesystem lists files and folders for a path.
 func (s *Sonarr) BrowseFilesystem(query *FilesystemQuery) ([]*starr.Path, error) {
@@ -85,7 +65,7 @@ func (s *Sonarr) GetFilesystemTypeContext(ctx context.Context, pathName string)
 	params := make(url.Values)
 	params.Set("path", pathName)
 
-	req := starr.Request{URI: path.Join(bpFilesystem, "type"), Query: params}
+	req := starr.Request{URI: starr.SetAPIPath(path.Join(bpFilesystem, "type")), Query: params}
 
 	resp, err := s.Get(ctx, req)
 	if err != nil {
@@ -0,0 +1,103 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestBrowseFilesystem(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name: "200",
+			ExpectedPath: path.Join("/", starr.API, sonarr.APIver, "filesystem") +
+				"?allowFoldersWithoutTrailingSlashes=false&includeFiles=true&path=%2Ftv%2F",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"name":"Show","path":"/tv/Show/"}]`,
+			WithRequest:    &sonarr.FilesystemQuery{Path: "/tv/", IncludeFiles: true},
+			WithResponse:   []*starr.Path{{Name: "Show", Path: "/tv/Show/"}},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "filesystem"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    (*sonarr.FilesystemQuery)(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []*starr.Path(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.BrowseFilesystem(test.WithRequest.(*sonarr.FilesystemQuery))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
+
+func TestBrowseFilesystemMediaFiles(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "filesystem", "mediafiles") + "?path=%2Fdownloads",
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `[{"path":"/downloads/Show.S01E01.mkv","relativePath":"Show.S01E01.mkv","name":"Show.S01E01.mkv"}]`,
+		WithResponse:   []*starr.Path{{Name: "Show.S01E01.mkv", Path: "/downloads/Show.S01E01.mkv"}},
+	}
+
+	client := sonarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	output, err := client.BrowseFilesystemMediaFiles("/downloads")
+	require.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+}
+
+func TestGetFilesystemType(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "filesystem", "type") + "?path=%2Ftv",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `{"type":"folder"}`,
+			WithResponse:   []byte(`{"type":"folder"}`),
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "filesystem", "type") + "?path=%2Ftv",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   []byte(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetFilesystemType("/tv")
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.Equal(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -54,6 +54,34 @@ type ImportListOutput struct {
 	Fields             []*starr.FieldOutput `json:"fields"`
 }
 
+// ToInput converts the output of an import list into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (l *ImportListOutput) ToInput() *ImportListInput {
+	if l == nil {
+		return nil
+	}
+
+	return &ImportListInput{
+		EnableAutomaticAdd: l.EnableAutomaticAdd,
+		SeasonFolder:       l.SeasonFolder,
+		ListOrder:          int(l.ListOrder), //nolint:gosec // small number.
+		QualityProfileID:   l.QualityProfileID,
+		ID:                 l.ID,
+		ConfigContract:     l.ConfigContract,
+		Implementation:     l.Implementation,
+		ImplementationName: l.ImplementationName,
+		InfoLink:           l.InfoLink,
+		ListType:           l.ListType,
+		MinRefreshInterval: l.MinRefreshInterval,
+		Name:               l.Name,
+		RootFolderPath:     l.RootFolderPath,
+		SeriesType:         l.SeriesType,
+		ShouldMonitor:      l.ShouldMonitor,
+		Tags:               l.Tags,
+		Fields:             starr.ToFieldInputs(l.Fields),
+	}
+}
+
 // GetImportLists returns all configured import lists.
 func (s *Sonarr) GetImportLists() ([]*ImportListOutput, error) {
 	return s.GetImportListsContext(context.Background())
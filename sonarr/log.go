@@ -3,49 +3,32 @@ package sonarr
 import (
 	"context"
 	"fmt"
+	"io"
 	"path"
-	"time"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpLog = APIver + "/log"
 
 // LogLine is one record from /api/v3/log.
-type LogLine struct {
-	ID            int       `json:"id"`
-	Time          time.Time `json:"time"`
-	Exception     string    `json:"exception,omitempty"`
-	ExceptionType string    `json:"exceptionType,omitempty"`
-	Level         string    `json:"level,omitempty"`
-	Logger        string    `json:"logger,omitempty"`
-	Message       string    `json:"message,omitempty"`
-	Method        string    `json:"method,omitempty"`
-}
+type LogLine = starrshared.LogLine
 
 // LogPage is a page of log lines from /api/v3/log.
-type LogPage struct {
-	Page          int        `json:"page"`
-	PageSize      int        `json:"pageSize"`
-	SortKey       string     `json:"sortKey"`
-	SortDirection string     `json:"sortDirection"`
-	TotalRecords  int        `json:"totalRecords"`
-	Records       []*LogLine `json:"records"`
-}
+type LogPage = starrshared.LogPage
 
 // LogFile describes a log file on disk.
-type LogFile struct {
-	Filename  string    `json:"filename,omitempty"`
-	Contents  string    `json:"contents,omitempty"`
-	LastWrite time.Time `json:"lastWrite,omitzero"`
-}
+type LogFile = starrshared.LogFile
 
 // GetLogPage returns a page of application log lines.
+// Set a "level" value in params to filter the lines by log level.
 func (s *Sonarr) GetLogPage(params *starr.PageReq) (*LogPage, error) {
 	return s.GetLogPageContext(context.Background(), params)
 }
 
 // GetLogPageContext returns a page of application log lines.
+// Set a "level" value in params to filter the lines by log level.
 func (s *Sonarr) GetLogPageContext(ctx context.Context, params *starr.PageReq) (*LogPage, error) {
 	var output LogPage
 
@@ -64,43 +47,67 @@ func (s *Sonarr) GetLogFiles() ([]*LogFile, error) {
 
 // GetLogFilesContext returns the list of log files.
 func (s *Sonarr) GetLogFilesContext(ctx context.Context) ([]*LogFile, error) {
-	var output []*LogFile
-
-	req := starr.Request{URI: path.Join(bpLog, "file")}
-	if err := s.GetInto(ctx, req, &output); err != nil {
-		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
-	}
-
-	return output, nil
+	return s.getLogFiles(ctx, path.Join(bpLog, "file"))
 }
 
 // GetLogFile returns the contents of a named log file.
+// Use OpenLogFile for large files.
 func (s *Sonarr) GetLogFile(filename string) (*LogFile, error) {
 	return s.GetLogFileContext(context.Background(), filename)
 }
 
 // GetLogFileContext returns the contents of a named log file.
+// Use OpenLogFileContext for large files.
 func (s *Sonarr) GetLogFileContext(ctx context.Context, filename string) (*LogFile, error) {
-	var output LogFile
+	return s.getLogFile(ctx, path.Join(bpLog, "file"), filename)
+}
 
-	req := starr.Request{URI: path.Join(bpLog, "file", filename)}
-	if err := s.GetInto(ctx, req, &output); err != nil {
-		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
-	}
+// OpenLogFile returns a reader for a named log file, so large files can be streamed.
+// Close the reader when finished with it.
+func (s *Sonarr) OpenLogFile(filename string) (io.ReadCloser, error) {
+	return s.OpenLogFileContext(context.Background(), filename)
+}
 
-	return &output, nil
+// OpenLogFileContext returns a reader for a named log file, so large files can be streamed.
+// Close the reader when finished with it.
+func (s *Sonarr) OpenLogFileContext(ctx context.Context, filename string) (io.ReadCloser, error) {
+	return s.openLogFile(ctx, path.Join(bpLog, "file", filename))
 }
 
-// UpdateLogFiles triggers a log file update/roll.
-func (s *Sonarr) UpdateLogFiles() ([]*LogFile, error) {
-	return s.UpdateLogFilesContext(context.Background())
+// GetUpdateLogFiles returns the list of update log files.
+func (s *Sonarr) GetUpdateLogFiles() ([]*LogFile, error) {
+	return s.GetUpdateLogFilesContext(context.Background())
 }
 
-// UpdateLogFilesContext triggers a log file update/roll.
-func (s *Sonarr) UpdateLogFilesContext(ctx context.Context) ([]*LogFile, error) {
+// GetUpdateLogFilesContext returns the list of update log files.
+func (s *Sonarr) GetUpdateLogFilesContext(ctx context.Context) ([]*LogFile, error) {
+	return s.getLogFiles(ctx, path.Join(bpLog, "file", "update"))
+}
+
+// GetUpdateLogFile returns the contents of a named update log file.
+func (s *Sonarr) GetUpdateLogFile(filename string) (*LogFile, error) {
+	return s.GetUpdateLogFileContext(context.Background(), filename)
+}
+
+// GetUpdateLogFileContext returns the contents of a named update log file.
+func (s *Sonarr) GetUpdateLogFileContext(ctx context.Context, filename string) (*LogFile, error) {
+	return s.getLogFile(ctx, path.Join(bpLog, "file", "update"), filename)
+}
+
+// OpenUpdateLogFile returns a reader for a named update log file. Close the reader when finished with it.
+func (s *Sonarr) OpenUpdateLogFile(filename string) (io.ReadCloser, error) {
+	return s.OpenUpdateLogFileContext(context.Background(), filename)
+}
+
+// OpenUpdateLogFileContext returns a reader for a named update log file. Close the reader when finished with it.
+func (s *Sonarr) OpenUpdateLogFileContext(ctx context.Context, filename string) (io.ReadCloser, error) {
+	return s.openLogFile(ctx, path.Join(bpLog, "file", "update", filename))
+}
+
+func (s *Sonarr) getLogFiles(ctx context.Context, uri string) ([]*LogFile, error) {
 	var output []*LogFile
 
-	req := starr.Request{URI: path.Join(bpLog, "file", "update")}
+	req := starr.Request{URI: uri}
 	if err := s.GetInto(ctx, req, &output); err != nil {
 		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
 	}
@@ -108,19 +115,62 @@ func (s *Sonarr) UpdateLogFilesContext(ctx context.Context) ([]*LogFile, error)
 	return output, nil
 }
 
-// UpdateLogFile triggers update for a specific log file.
+func (s *Sonarr) getLogFile(ctx context.Context, uri, filename string) (*LogFile, error) {
+	body, err := s.openLogFile(ctx, path.Join(uri, filename))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	contents, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("reading log file %s: %w", filename, err)
+	}
+
+	return &LogFile{Filename: filename, Contents: string(contents)}, nil
+}
+
+// openLogFile returns the body of a log file. The apps serve log files as plain text, not json.
+func (s *Sonarr) openLogFile(ctx context.Context, uri string) (io.ReadCloser, error) {
+	req := starr.Request{URI: starr.SetAPIPath(uri)}
+
+	resp, err := s.Get(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return resp.Body, nil
+}
+
+// UpdateLogFiles returns the list of update log files.
+//
+// Deprecated: Use GetUpdateLogFiles() instead.
+func (s *Sonarr) UpdateLogFiles() ([]*LogFile, error) {
+	return s.GetUpdateLogFilesContext(context.Background())
+}
+
+// UpdateLogFilesContext returns the list of update log files.
+//
+// Deprecated: Use GetUpdateLogFilesContext() instead.
+func (s *Sonarr) UpdateLogFilesContext(ctx context.Context) ([]*LogFile, error) {
+	return s.GetUpdateLogFilesContext(ctx)
+}
+
+// UpdateLogFile returns the contents of a named update log file.
+//
+// Deprecated: Use GetUpdateLogFile() instead.
 func (s *Sonarr) UpdateLogFile(filename string) ([]*LogFile, error) {
 	return s.UpdateLogFileContext(context.Background(), filename)
 }
 
-// UpdateLogFileContext triggers update for a specific log file.
+// UpdateLogFileContext returns the contents of a named update log file.
+//
+// Deprecated: Use GetUpdateLogFileContext() instead.
 func (s *Sonarr) UpdateLogFileContext(ctx context.Context, filename string) ([]*LogFile, error) {
-	var output []*LogFile
-
-	req := starr.Request{URI: path.Join(bpLog, "file", "update", filename)}
-	if err := s.GetInto(ctx, req, &output); err != nil {
-		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	file, err := s.GetUpdateLogFileContext(ctx, filename)
+	if err != nil {
+		return nil, err
 	}
 
-	return output, nil
+	return []*LogFile{file}, nil
 }
@@ -2,16 +2,18 @@ package sonarr
 
 import (
 	"context"
-	"fmt"
-	"io"
 	"net/url"
 	"path"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpMediaCover = APIver + "/mediacover"
 
+// MediaCover is an open media cover image download.
+type MediaCover = starrshared.MediaCover
+
 // GetMediaCover downloads a series media cover image (jpg, png, or gif).
 func (s *Sonarr) GetMediaCover(seriesID int64, filename string) ([]byte, error) {
 	return s.GetMediaCoverContext(context.Background(), seriesID, filename)
@@ -19,20 +21,19 @@ func (s *Sonarr) GetMediaCover(seriesID int64, filename string) ([]byte, error)
 
 // GetMediaCoverContext downloads a series media cover image (jpg, png, or gif).
 func (s *Sonarr) GetMediaCoverContext(ctx context.Context, seriesID int64, filename string) ([]byte, error) {
-	uri := starr.SetAPIPath(path.Join(bpMediaCover, starr.Str(seriesID), url.PathEscape(filename)))
-
-	req := starr.Request{URI: uri}
-
-	resp, err := s.Get(ctx, req)
-	if err != nil {
-		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
-	}
-	defer resp.Body.Close()
+	uri := path.Join(bpMediaCover, starr.Str(seriesID), url.PathEscape(filename))
+	return starrshared.ReadMediaCover(ctx, s.APIer, uri)
+}
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("reading response body from %s: %w", uri, err)
-	}
+// OpenMediaCover opens a series media cover image; pass a previous ETag to skip an unchanged image.
+func (s *Sonarr) OpenMediaCover(seriesID int64, filename, etag string) (*MediaCover, error) {
+	return s.OpenMediaCoverContext(context.Background(), seriesID, filename, etag)
+}
 
-	return body, nil
+// OpenMediaCoverContext opens a series media cover image; pass a previous ETag to skip an unchanged image.
+func (s *Sonarr) OpenMediaCoverContext(
+	ctx context.Context, seriesID int64, filename, etag string,
+) (*MediaCover, error) {
+	uri := path.Join(bpMediaCover, starr.Str(seriesID), url.PathEscape(filename))
+	return starrshared.OpenMediaCover(ctx, s.APIer, uri, etag)
 }
@@ -9,41 +9,19 @@ import (
 	"path"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpMetadata = APIver + "/metadata"
 
 // MetadataProviderMessage is the provider message object on metadata consumers.
-type MetadataProviderMessage struct {
-	Message string `json:"message,omitempty"`
-	Type    string `json:"type,omitempty"`
-}
+type MetadataProviderMessage = starrshared.MetadataProviderMessage
 
 // MetadataOutput is the output from /api/v3/metadata (MetadataResource).
-type MetadataOutput struct {
-	ID                 int64                    `json:"id,omitempty"`
-	Name               string                   `json:"name,omitempty"`
-	Fields             []*starr.FieldOutput     `json:"fields,omitempty"`
-	ImplementationName string                   `json:"implementationName,omitempty"`
-	Implementation     string                   `json:"implementation,omitempty"`
-	ConfigContract     string                   `json:"configContract,omitempty"`
-	InfoLink           string                   `json:"infoLink,omitempty"`
-	Message            *MetadataProviderMessage `json:"message,omitempty"`
-	Tags               []int                    `json:"tags,omitempty"`
-	Presets            []*MetadataOutput        `json:"presets,omitempty"`
-	Enable             bool                     `json:"enable"`
-}
+type MetadataOutput = starrshared.MetadataOutput
 
 // MetadataInput is the input for creating or updating metadata consumers.
-type MetadataInput struct {
-	ID             int64               `json:"id,omitempty"`
-	Name           string              `json:"name,omitempty"`
-	Fields         []*starr.FieldInput `json:"fields,omitempty"`
-	Implementation string              `json:"implementation,omitempty"`
-	ConfigContract string              `json:"configContract,omitempty"`
-	Tags           []int               `json:"tags,omitempty"`
-	Enable         bool                `json:"enable"`
-}
+type MetadataInput = starrshared.MetadataInput
 
 // GetMetadata returns all configured metadata consumers.
 func (s *Sonarr) GetMetadata() ([]*MetadataOutput, error) {
@@ -5,6 +5,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"path"
 
 	"golift.io/starr"
 )
@@ -82,3 +84,54 @@ func (s *Sonarr) UpdateNamingContext(ctx context.Context, naming *Naming) (*Nami
 
 	return &output, nil
 }
+
+// NamingExamples are the file and folder names rendered from a naming config by PreviewNaming.
+// An example is empty when its format is empty or invalid.
+type NamingExamples struct {
+	SingleEpisodeExample     string `json:"singleEpisodeExample"`
+	MultiEpisodeExample      string `json:"multiEpisodeExample"`
+	DailyEpisodeExample      string `json:"dailyEpisodeExample"`
+	AnimeEpisodeExample      string `json:"animeEpisodeExample"`
+	AnimeMultiEpisodeExample string `json:"animeMultiEpisodeExample"`
+	SeriesFolderExample      string `json:"seriesFolderExample"`
+	SeasonFolderExample      string `json:"seasonFolderExample"`
+	SpecialsFolderExample    string `json:"specialsFolderExample"`
+}
+
+// PreviewNaming renders example file and folder names from a naming config, without saving it.
+func (s *Sonarr) PreviewNaming(naming *Naming) (*NamingExamples, error) {
+	return s.PreviewNamingContext(context.Background(), naming)
+}
+
+// PreviewNamingContext renders example file and folder names from a naming config, without saving it.
+func (s *Sonarr) PreviewNamingContext(ctx context.Context, naming *Naming) (*NamingExamples, error) {
+	var output NamingExamples
+
+	req := starr.Request{URI: path.Join(bpNaming, "examples"), Query: naming.values()}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// values turns a naming config into the query parameters for the examples endpoint.
+func (n *Naming) values() url.Values {
+	if n == nil {
+		n = &Naming{}
+	}
+
+	return url.Values{
+		"renameEpisodes":               {starr.Str(n.RenameEpisodes)},
+		"replaceIllegalCharacters":     {starr.Str(n.ReplaceIllegalCharacters)},
+		"colonReplacementFormat":       {starr.Str(int(n.ColonReplacementFormat))},
+		"customColonReplacementFormat": {n.CustomColonReplacementFormat},
+		"multiEpisodeStyle":            {starr.Str(n.MultiEpisodeStyle)},
+		"standardEpisodeFormat":        {n.StandardEpisodeFormat},
+		"dailyEpisodeFormat":           {n.DailyEpisodeFormat},
+		"animeEpisodeFormat":           {n.AnimeEpisodeFormat},
+		"seriesFolderFormat":           {n.SeriesFolderFormat},
+		"seasonFolderFormat":           {n.SeasonFolderFormat},
+		"specialsFolderFormat":         {n.SpecialsFolderFormat},
+	}
+}
@@ -145,3 +145,38 @@ func TestUpdateNaming(t *testing.T) {
 		})
 	}
 }
+
+func TestPreviewNaming(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath: path.Join("/", starr.API, sonarr.APIver, "config", "naming", "examples") +
+			"?animeEpisodeFormat=&colonReplacementFormat=1&customColonReplacementFormat=&dailyEpisodeFormat=" +
+			"&multiEpisodeStyle=5&renameEpisodes=true&replaceIllegalCharacters=true" +
+			"&seasonFolderFormat=Season+%7Bseason%7D&seriesFolderFormat=%7BSeries+Title%7D&specialsFolderFormat=" +
+			"&standardEpisodeFormat=%7BSeries+Title%7D+-+S%7Bseason%3A00%7DE%7Bepisode%3A00%7D",
+		ExpectedMethod: http.MethodGet,
+		ResponseStatus: http.StatusOK,
+		ResponseBody: `{"singleEpisodeExample":"The Series Title! - S01E01",` +
+			`"seriesFolderExample":"The Series Title!","seasonFolderExample":"Season 1"}`,
+		WithRequest: &sonarr.Naming{
+			RenameEpisodes:           true,
+			ReplaceIllegalCharacters: true,
+			ColonReplacementFormat:   sonarr.ColonReplaceWithDash,
+			MultiEpisodeStyle:        5,
+			StandardEpisodeFormat:    "{Series Title} - S{season:00}E{episode:00}",
+			SeriesFolderFormat:       "{Series Title}",
+			SeasonFolderFormat:       "Season {season}",
+		},
+		WithResponse: &sonarr.NamingExamples{
+			SingleEpisodeExample: "The Series Title! - S01E01",
+			SeriesFolderExample:  "The Series Title!",
+			SeasonFolderExample:  "Season 1",
+		},
+	}
+
+	client := sonarr.New(starr.New("mockAPIkey", test.GetMockServer(t).URL, 0))
+	output, err := client.PreviewNaming(test.WithRequest.(*sonarr.Naming))
+	require.NoError(t, err)
+	assert.EqualValues(t, test.WithResponse, output)
+}
@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"path"
+	"time"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 // Define Base Path for Quality Definition calls.
@@ -107,3 +109,45 @@ func (s *Sonarr) UpdateQualityDefinitionsContext(
 
 	return output, nil
 }
+
+// QualityDefinitionLimits is the /api/v3/qualitydefinition/limits endpoint.
+type QualityDefinitionLimits = starrshared.QualityDefinitionLimits
+
+// QualitySize is the range of release sizes, in bytes, that a quality definition accepts.
+type QualitySize = starrshared.QualitySize
+
+// GetQualityDefinitionLimits returns the lowest and highest sizes allowed in a quality definition.
+func (s *Sonarr) GetQualityDefinitionLimits() (*QualityDefinitionLimits, error) {
+	return s.GetQualityDefinitionLimitsContext(context.Background())
+}
+
+// GetQualityDefinitionLimitsContext returns the lowest and highest sizes allowed in a quality definition.
+func (s *Sonarr) GetQualityDefinitionLimitsContext(ctx context.Context) (*QualityDefinitionLimits, error) {
+	var output QualityDefinitionLimits
+
+	req := starr.Request{URI: path.Join(bpQualityDefinition, "limits")}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// Size returns the release sizes this quality definition accepts for a runtime.
+// Sizes are megabytes per minute. Convert a runtime in minutes
+// with time.Duration(runtime) * time.Minute, or use a starr.PlayTime's Duration.
+func (q *QualityDefinition) Size(runtime time.Duration) *QualitySize {
+	bytes := starrshared.Megabyte * runtime.Minutes()
+
+	return &QualitySize{
+		Min:       int64(q.MinSize * bytes),
+		Preferred: int64(q.PrefSize * bytes),
+		Max:       int64(q.MaxSize * bytes),
+	}
+}
+
+// Accepts returns true if a release of this many bytes and this runtime is inside the quality definition's sizes.
+// Like the app, a zero runtime accepts every size.
+func (q *QualityDefinition) Accepts(size int64, runtime time.Duration) bool {
+	return q.Size(runtime).Accepts(size)
+}
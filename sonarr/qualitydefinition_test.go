@@ -0,0 +1,25 @@
+package sonarr_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golift.io/starr/sonarr"
+)
+
+func TestQualityDefinitionSize(t *testing.T) {
+	t.Parallel()
+
+	definition := &sonarr.QualityDefinition{MinSize: 2, PrefSize: 10, MaxSize: 20}
+	runtime := 45 * time.Minute
+	size := definition.Size(runtime)
+	assert.Equal(t, &sonarr.QualitySize{Min: 90 << 20, Preferred: 450 << 20, Max: 900 << 20}, size)
+	assert.True(t, definition.Accepts(500<<20, runtime))
+	assert.False(t, definition.Accepts(50<<20, runtime), "too small")
+	assert.False(t, definition.Accepts(1<<30, runtime), "too big")
+	assert.True(t, definition.Accepts(1<<40, 0), "a zero runtime accepts every size")
+
+	definition.MaxSize = 0
+	assert.True(t, definition.Accepts(1<<40, runtime), "a zero max size is unlimited")
+}
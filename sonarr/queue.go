@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"golift.io/starr"
+	"golift.io/starr/starrshared"
 )
 
 const bpQueue = APIver + "/queue"
@@ -51,16 +52,7 @@ type QueueRecord struct {
 }
 
 // QueueStatus is the aggregate queue status from /api/v3/queue/status.
-type QueueStatus struct {
-	ID              int  `json:"id,omitempty"`
-	TotalCount      int  `json:"totalCount,omitempty"`
-	Count           int  `json:"count,omitempty"`
-	UnknownCount    int  `json:"unknownCount,omitempty"`
-	Errors          bool `json:"errors"`
-	Warnings        bool `json:"warnings"`
-	UnknownErrors   bool `json:"unknownErrors"`
-	UnknownWarnings bool `json:"unknownWarnings"`
-}
+type QueueStatus = starrshared.QueueStatus
 
 // GetQueue returns a single page from the Sonarr Queue (processing, but not yet imported).
 // If you need control over the page, use sonarr.GetQueuePage().
@@ -168,13 +160,45 @@ func (s *Sonarr) QueueGrabContext(ctx context.Context, ids ...int64) error {
 	return nil
 }
 
+// GetQueueRecords returns the queue records without paging, optionally filtered.
+// Pass a zero seriesID and no episodeIDs to return the whole queue.
+func (s *Sonarr) GetQueueRecords(seriesID int64, episodeIDs ...int64) ([]*QueueRecord, error) {
+	return s.GetQueueRecordsContext(context.Background(), seriesID, episodeIDs...)
+}
+
+// GetQueueRecordsContext returns the queue records without paging, optionally filtered.
+// Pass a zero seriesID and no episodeIDs to return the whole queue.
+func (s *Sonarr) GetQueueRecordsContext(
+	ctx context.Context, seriesID int64, episodeIDs ...int64,
+) ([]*QueueRecord, error) {
+	var output []*QueueRecord
+
+	req := starr.Request{URI: path.Join(bpQueue, "details"), Query: make(url.Values)}
+	if seriesID != 0 {
+		req.Query.Set("seriesId", starr.Str(seriesID))
+	}
+
+	for _, id := range episodeIDs {
+		req.Query.Add("episodeIds", starr.Str(id))
+	}
+
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
 // GetQueueDetails returns the raw JSON array from /api/v3/queue/details.
-// Unmarshal into your own structs if you need typed fields beyond the main queue list.
+//
+// Deprecated: Use GetQueueRecords() instead.
 func (s *Sonarr) GetQueueDetails(query url.Values) ([]byte, error) {
 	return s.GetQueueDetailsContext(context.Background(), query)
 }
 
 // GetQueueDetailsContext returns the raw JSON array from /api/v3/queue/details.
+//
+// Deprecated: Use GetQueueRecordsContext() instead.
 func (s *Sonarr) GetQueueDetailsContext(ctx context.Context, query url.Values) ([]byte, error) {
 	uri := starr.SetAPIPath(path.Join(bpQueue, "details"))
 	req := starr.Request{URI: uri, Query: query}
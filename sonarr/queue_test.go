@@ -0,0 +1,53 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestGetQueueRecords(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "queue", "details") + "?episodeIds=4&episodeIds=5&seriesId=7",
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusOK,
+			ResponseBody:   `[{"id":3,"seriesId":7,"episodeId":4,"title":"Show.S01E01.1080p","status":"delay","protocol":"usenet"}]`,
+			WithRequest:    []int64{7, 4, 5},
+			WithResponse: []*sonarr.QueueRecord{
+				{ID: 3, SeriesID: 7, EpisodeID: 4, Title: "Show.S01E01.1080p", Status: "delay", Protocol: starr.ProtocolUsenet},
+			},
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "queue", "details"),
+			ExpectedMethod: http.MethodGet,
+			ResponseStatus: http.StatusNotFound,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithRequest:    []int64{0},
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+			WithResponse:   ([]*sonarr.QueueRecord)(nil),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			ids := test.WithRequest.([]int64)
+			output, err := client.GetQueueRecords(ids[0], ids[1:]...)
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package sonarr_test
+
+import (
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtest"
+)
+
+func TestPushRelease(t *testing.T) {
+	t.Parallel()
+
+	test := &starrtest.MockData{
+		ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "release", "push"),
+		ExpectedMethod: http.MethodPost,
+		ExpectedRequest: `{"title":"Show.S01E01.1080p.WEB-DL","downloadUrl":"http://indexer/get/1",` +
+			`"protocol":"usenet","publishDate":"2020-01-01T00:00:00Z","indexer":"Indexer"}` + "\n",
+		ResponseStatus: http.StatusOK,
+		ResponseBody:   `[{"title":"Show.S01E01.1080p.WEB-DL","approved":false,"rejections":["Unknown Series"]}]`,
+	}
+	mockServer := test.GetMockServer(t)
+	client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+
+	output, err := client.PushRelease(&sonarr.PushRelease{
+		Title:       "Show.S01E01.1080p.WEB-DL",
+		DownloadURL: "http://indexer/get/1",
+		Protocol:    starr.ProtocolUsenet,
+		PublishDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Indexer:     "Indexer",
+	})
+	require.NoError(t, err)
+	require.Len(t, output, 1)
+	assert.Equal(t, "Show.S01E01.1080p.WEB-DL", output[0].Title)
+	assert.False(t, output[0].Approved)
+	assert.Equal(t, []string{"Unknown Series"}, output[0].Rejections)
+}
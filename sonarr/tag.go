@@ -104,3 +104,51 @@ func (s *Sonarr) DeleteTagContext(ctx context.Context, tagID int) error {
 
 	return nil
 }
+
+// TagDetails is the /api/v3/tag/detail resource.
+type TagDetails struct {
+	ID                int    `json:"id"`
+	Label             string `json:"label,omitempty"`
+	DelayProfileIDs   []int  `json:"delayProfileIds,omitempty"`
+	ImportListIDs     []int  `json:"importListIds,omitempty"`
+	NotificationIDs   []int  `json:"notificationIds,omitempty"`
+	RestrictionIDs    []int  `json:"restrictionIds,omitempty"` // Release profiles.
+	IndexerIDs        []int  `json:"indexerIds,omitempty"`
+	DownloadClientIDs []int  `json:"downloadClientIds,omitempty"`
+	AutoTagIDs        []int  `json:"autoTagIds,omitempty"`
+	SeriesIDs         []int  `json:"seriesIds,omitempty"`
+}
+
+// GetTagDetails returns tag usage details for all tags.
+func (s *Sonarr) GetTagDetails() ([]*TagDetails, error) {
+	return s.GetTagDetailsContext(context.Background())
+}
+
+// GetTagDetailsContext returns tag usage details for all tags.
+func (s *Sonarr) GetTagDetailsContext(ctx context.Context) ([]*TagDetails, error) {
+	var output []*TagDetails
+
+	req := starr.Request{URI: path.Join(bpTag, "detail")}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return output, nil
+}
+
+// GetTagDetail returns tag usage details for a single tag.
+func (s *Sonarr) GetTagDetail(tagID int) (*TagDetails, error) {
+	return s.GetTagDetailContext(context.Background(), tagID)
+}
+
+// GetTagDetailContext returns tag usage details for a single tag.
+func (s *Sonarr) GetTagDetailContext(ctx context.Context, tagID int) (*TagDetails, error) {
+	var output TagDetails
+
+	req := starr.Request{URI: path.Join(bpTag, "detail", starr.Str(tagID))}
+	if err := s.GetInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
@@ -228,3 +228,47 @@ func TestDeleteTag(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTagDetail(t *testing.T) {
+	t.Parallel()
+
+	tests := []*starrtest.MockData{
+		{
+			Name:           "200",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "tag", "detail", "1"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 200,
+			WithRequest:    1,
+			ResponseBody:   `{"id": 1, "label": "amzn", "restrictionIds": [2], "indexerIds": [3, 4], "seriesIds": [5]}`,
+			WithResponse: &sonarr.TagDetails{
+				ID:             1,
+				Label:          "amzn",
+				RestrictionIDs: []int{2},
+				IndexerIDs:     []int{3, 4},
+				SeriesIDs:      []int{5},
+			},
+			WithError: nil,
+		},
+		{
+			Name:           "404",
+			ExpectedPath:   path.Join("/", starr.API, sonarr.APIver, "tag", "detail", "1"),
+			ExpectedMethod: "GET",
+			ResponseStatus: 404,
+			WithRequest:    1,
+			ResponseBody:   `{"message": "NotFound"}`,
+			WithResponse:   (*sonarr.TagDetails)(nil),
+			WithError:      &starr.ReqError{Code: http.StatusNotFound},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			t.Parallel()
+			mockServer := test.GetMockServer(t)
+			client := sonarr.New(starr.New("mockAPIkey", mockServer.URL, 0))
+			output, err := client.GetTagDetail(test.WithRequest.(int))
+			require.ErrorIs(t, err, test.WithError, "error is not the same as expected")
+			assert.EqualValues(t, test.WithResponse, output, "response is not the same as expected")
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package starrshared
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// iCal date and date-time formats.
+const (
+	feedDate        = "20060102"
+	feedDateTime    = "20060102T150405"
+	feedDateTimeUTC = "20060102T150405Z"
+)
+
+// FeedEvent is one VEVENT from a calendar ICS feed, from the /feed/calendar path shared by all Starr apps
+// except Prowlarr. Each app writes its own summary, description and categories from the release it lists.
+type FeedEvent struct {
+	UID         string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool // Start and End are dates at midnight UTC.
+	Summary     string
+	Description string
+	Location    string
+	Status      string
+	Categories  []string
+}
+
+// ParseFeed parses the events out of a calendar ICS feed. Properties this package does not know are skipped.
+func ParseFeed(input io.Reader) ([]*FeedEvent, error) {
+	lines, err := unfoldFeed(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events []*FeedEvent
+		event  *FeedEvent
+		nested int // Components inside an event, like VALARM, have their own properties.
+	)
+
+	for _, line := range lines {
+		name, params, value := splitFeedLine(line)
+
+		switch {
+		case event == nil && name == "BEGIN" && strings.EqualFold(value, "VEVENT"):
+			event = &FeedEvent{}
+		case event == nil:
+			continue
+		case name == "BEGIN":
+			nested++
+		case name == "END" && nested > 0:
+			nested--
+		case name == "END" && strings.EqualFold(value, "VEVENT"):
+			events = append(events, event)
+			event = nil
+		case nested == 0:
+			if err := event.set(name, params, value); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return events, nil
+}
+
+// set stores one property on an event.
+func (e *FeedEvent) set(name string, params map[string]string, value string) error {
+	var err error
+
+	switch name {
+	case "UID":
+		e.UID = unescapeFeedText(value)
+	case "SUMMARY":
+		e.Summary = unescapeFeedText(value)
+	case "DESCRIPTION":
+		e.Description = unescapeFeedText(value)
+	case "LOCATION":
+		e.Location = unescapeFeedText(value)
+	case "STATUS":
+		e.Status = value
+	case "CATEGORIES":
+		e.Categories = append(e.Categories, splitFeedText(value)...)
+	case "DTSTART":
+		e.Start, e.AllDay, err = parseFeedTime(params, value)
+	case "DTEND":
+		e.End, _, err = parseFeedTime(params, value)
+	}
+
+	if err != nil {
+		return fmt.Errorf("parsing %s %q: %w", name, value, err)
+	}
+
+	return nil
+}
+
+// unfoldFeed joins folded lines. Folded lines start with a space or a tab.
+func unfoldFeed(input io.Reader) ([]string, error) {
+	var lines []string
+
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		switch {
+		case line == "":
+			continue
+		case (line[0] == ' ' || line[0] == '\t') && len(lines) > 0:
+			lines[len(lines)-1] += line[1:]
+		default:
+			lines = append(lines, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading feed: %w", err)
+	}
+
+	return lines, nil
+}
+
+// splitFeedLine splits a content line like DTSTART;TZID=America/New_York:20260412T100000
+// into its upper case name, parameters and value.
+func splitFeedLine(line string) (string, map[string]string, string) {
+	quoted := false
+	split := len(line)
+
+	for idx, char := range line {
+		if char == '"' {
+			quoted = !quoted
+		} else if char == ':' && !quoted {
+			split = idx
+			break
+		}
+	}
+
+	value := ""
+	if split < len(line) {
+		value = line[split+1:]
+	}
+
+	parts := strings.Split(line[:split], ";")
+	params := make(map[string]string)
+
+	for _, param := range parts[1:] {
+		if key, val, ok := strings.Cut(param, "="); ok {
+			params[strings.ToUpper(key)] = strings.Trim(val, `"`)
+		}
+	}
+
+	return strings.ToUpper(parts[0]), params, value
+}
+
+// parseFeedTime parses a date or date-time value. The bool is true for dates.
+// Date-times without a time zone are in TZID, or UTC when TZID is missing or unknown.
+func parseFeedTime(params map[string]string, value string) (time.Time, bool, error) {
+	if strings.EqualFold(params["VALUE"], "DATE") || len(value) == len(feedDate) {
+		date, err := time.Parse(feedDate, value)
+		return date, true, err //nolint:wrapcheck // The caller wraps it.
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		date, err := time.Parse(feedDateTimeUTC, value)
+		return date, false, err //nolint:wrapcheck // The caller wraps it.
+	}
+
+	loc := time.UTC
+	if tzid := params["TZID"]; tzid != "" {
+		if zone, err := time.LoadLocation(tzid); err == nil {
+			loc = zone
+		}
+	}
+
+	date, err := time.ParseInLocation(feedDateTime, value, loc)
+
+	return date, false, err //nolint:wrapcheck // The caller wraps it.
+}
+
+// splitFeedText splits a list value on the commas that are not escaped.
+func splitFeedText(value string) []string {
+	var (
+		list    []string
+		current strings.Builder
+	)
+
+	for idx := 0; idx < len(value); idx++ {
+		switch {
+		case value[idx] == '\\' && idx+1 < len(value):
+			current.WriteString(value[idx : idx+2])
+			idx++
+		case value[idx] == ',':
+			list = append(list, unescapeFeedText(current.String()))
+			current.Reset()
+		default:
+			current.WriteByte(value[idx])
+		}
+	}
+
+	return append(list, unescapeFeedText(current.String()))
+}
+
+// unescapeFeedText turns an escaped iCal text value into plain text.
+func unescapeFeedText(value string) string {
+	if !strings.Contains(value, `\`) {
+		return value
+	}
+
+	var output strings.Builder
+
+	for idx := 0; idx < len(value); idx++ {
+		if value[idx] != '\\' || idx+1 == len(value) {
+			output.WriteByte(value[idx])
+			continue
+		}
+
+		idx++
+
+		switch value[idx] {
+		case 'n', 'N':
+			output.WriteByte('\n')
+		default: // \\ \; \,
+			output.WriteByte(value[idx])
+		}
+	}
+
+	return output.String()
+}
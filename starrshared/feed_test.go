@@ -0,0 +1,99 @@
+package starrshared_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata" // The TZID test needs a time zone on hosts without zoneinfo files.
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr/starrshared"
+)
+
+func TestParseFeed(t *testing.T) {
+	t.Parallel()
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		input string
+		want  []*starrshared.FeedEvent
+		err   string
+	}{
+		{
+			name: "folded, escaped and nested",
+			input: "BEGIN:VCALENDAR\r\n" +
+				"X-WR-CALNAME:Sonarr TV Schedule\r\n" +
+				"SUMMARY:Not in an event\r\n" +
+				"BEGIN:VEVENT\r\n" +
+				"UID:NzbDrone_episode_101\r\n" +
+				"DTSTART:20260412T010000Z\r\n" +
+				"DTEND:20260412T020000Z\r\n" +
+				"SUMMARY:The Show - 1x01 - Pilot\r\n" +
+				"DESCRIPTION:A long overview\\, with escapes\\; that is folded\r\n" +
+				"  across two lines.\\nSecond paragraph.\r\n" +
+				"CATEGORIES:HBO,Drama\\, Crime\r\n" +
+				"STATUS:CONFIRMED\r\n" +
+				"X-UNKNOWN:skipped\r\n" +
+				"BEGIN:VALARM\r\n" +
+				"DESCRIPTION:Reminder\r\n" +
+				"END:VALARM\r\n" +
+				"END:VEVENT\r\n" +
+				"END:VCALENDAR\r\n",
+			want: []*starrshared.FeedEvent{{
+				UID:         "NzbDrone_episode_101",
+				Start:       time.Date(2026, 4, 12, 1, 0, 0, 0, time.UTC),
+				End:         time.Date(2026, 4, 12, 2, 0, 0, 0, time.UTC),
+				Summary:     "The Show - 1x01 - Pilot",
+				Description: "A long overview, with escapes; that is folded across two lines.\nSecond paragraph.",
+				Status:      "CONFIRMED",
+				Categories:  []string{"HBO", "Drama, Crime"},
+			}},
+		},
+		{
+			name: "time zones and dates",
+			input: "BEGIN:VEVENT\n" +
+				"DTSTART;TZID=America/New_York:20260419T210000\n" +
+				"DTEND;TZID=Nowhere/Unknown:20260419T220000\n" +
+				"END:VEVENT\n" +
+				"BEGIN:VEVENT\n" +
+				"DTSTART;VALUE=DATE:20260420\n" +
+				"DTEND:20260421\n" +
+				"END:VEVENT\n",
+			want: []*starrshared.FeedEvent{
+				{
+					Start: time.Date(2026, 4, 19, 21, 0, 0, 0, newYork),
+					End:   time.Date(2026, 4, 19, 22, 0, 0, 0, time.UTC),
+				},
+				{
+					Start:  time.Date(2026, 4, 20, 0, 0, 0, 0, time.UTC),
+					End:    time.Date(2026, 4, 21, 0, 0, 0, 0, time.UTC),
+					AllDay: true,
+				},
+			},
+		},
+		{
+			name:  "bad date",
+			input: "BEGIN:VEVENT\nDTSTART:2026-04-19\nEND:VEVENT\n",
+			err:   `parsing DTSTART "2026-04-19"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			events, err := starrshared.ParseFeed(strings.NewReader(test.input))
+			if test.err != "" {
+				require.ErrorContains(t, err, test.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.want, events)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package starrshared
+
+import (
+	"net/url"
+
+	"golift.io/starr"
+)
+
+// FilesystemQuery is the query for the /filesystem API resource shared by all Starr app clients.
+type FilesystemQuery struct {
+	Path                               string
+	IncludeFiles                       bool
+	AllowFoldersWithoutTrailingSlashes bool
+}
+
+// Values builds query parameters for the filesystem browser.
+func (q *FilesystemQuery) Values() url.Values {
+	val := make(url.Values)
+	if q == nil {
+		return val
+	}
+
+	if q.Path != "" {
+		val.Set("path", q.Path)
+	}
+
+	val.Set("includeFiles", starr.Str(q.IncludeFiles))
+	val.Set("allowFoldersWithoutTrailingSlashes", starr.Str(q.AllowFoldersWithoutTrailingSlashes))
+
+	return val
+}
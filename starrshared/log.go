@@ -0,0 +1,36 @@
+package starrshared
+
+import "time"
+
+// LogLine is one record from the /log API resource shared by all Starr app clients.
+type LogLine struct {
+	ID            int       `json:"id"`
+	Time          time.Time `json:"time"`
+	Exception     string    `json:"exception,omitempty"`
+	ExceptionType string    `json:"exceptionType,omitempty"`
+	Level         string    `json:"level,omitempty"`
+	Logger        string    `json:"logger,omitempty"`
+	Message       string    `json:"message,omitempty"`
+	Method        string    `json:"method,omitempty"`
+}
+
+// LogPage is a page of log lines from the /log API resource.
+type LogPage struct {
+	Page          int        `json:"page"`
+	PageSize      int        `json:"pageSize"`
+	SortKey       string     `json:"sortKey"`
+	SortDirection string     `json:"sortDirection"`
+	TotalRecords  int        `json:"totalRecords"`
+	Records       []*LogLine `json:"records"`
+}
+
+// LogFile describes a log file on disk, from the /log/file and /log/file/update API resources.
+// Contents is only filled in by the GetLogFile methods; the apps serve log files as plain text.
+type LogFile struct {
+	ID          int       `json:"id,omitempty"`
+	Filename    string    `json:"filename,omitempty"`
+	LastWrite   time.Time `json:"lastWriteTime,omitzero"`
+	ContentsURL string    `json:"contentsUrl,omitempty"`
+	DownloadURL string    `json:"downloadUrl,omitempty"`
+	Contents    string    `json:"contents,omitempty"`
+}
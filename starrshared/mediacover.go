@@ -0,0 +1,88 @@
+package starrshared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"golift.io/starr"
+)
+
+// MediaCover is an open media cover (poster, fanart, banner, etc) image download,
+// from the /mediacover API resource. Read and Close it.
+type MediaCover struct {
+	io.ReadCloser // Nil when NotModified is true.
+
+	ContentType   string
+	ContentLength int64 // -1 when unknown.
+	// ETag identifies this version of the image. Pass it to the next Open call to skip the download
+	// when the image has not changed.
+	ETag         string
+	LastModified time.Time
+	// NotModified is true when the provided ETag matches the image, and there is nothing to read.
+	NotModified bool
+}
+
+// OpenMediaCover requests a media cover image from an app. The uri is an API path, like v3/mediacover/1/poster.jpg.
+// A non-empty etag is sent as If-None-Match, and a 304 Not Modified response returns a NotModified cover.
+func OpenMediaCover(ctx context.Context, api starr.APIer, uri, etag string) (*MediaCover, error) {
+	req := starr.Request{URI: starr.SetAPIPath(uri)}
+	if etag != "" {
+		req.Headers = http.Header{"If-None-Match": {etag}}
+	}
+
+	resp, err := api.Get(ctx, req)
+
+	var reqErr *starr.ReqError
+	if errors.As(err, &reqErr) && reqErr.Code == http.StatusNotModified {
+		return &MediaCover{ETag: etag, ContentLength: -1, NotModified: true}, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	return newMediaCover(resp), nil
+}
+
+// ReadMediaCover downloads a media cover image from an app. The uri is the same as OpenMediaCover's.
+func ReadMediaCover(ctx context.Context, api starr.APIer, uri string) ([]byte, error) {
+	cover, err := OpenMediaCover(ctx, api, uri, "")
+	if err != nil {
+		return nil, err
+	}
+	defer cover.Close()
+
+	body, err := io.ReadAll(cover)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body from %s: %w", uri, err)
+	}
+
+	return body, nil
+}
+
+// newMediaCover returns a media cover download from an app response.
+func newMediaCover(resp *http.Response) *MediaCover {
+	cover := &MediaCover{
+		ReadCloser:    resp.Body,
+		ContentType:   resp.Header.Get("Content-Type"),
+		ContentLength: resp.ContentLength,
+		ETag:          resp.Header.Get("ETag"),
+	}
+
+	if modified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		cover.LastModified = modified
+	}
+
+	return cover
+}
+
+// Close closes the download. It is safe to call on a NotModified cover.
+func (m *MediaCover) Close() error {
+	if m.ReadCloser == nil {
+		return nil
+	}
+
+	return m.ReadCloser.Close() //nolint:wrapcheck // Same as closing a response body.
+}
@@ -0,0 +1,54 @@
+package starrshared
+
+import "golift.io/starr"
+
+// MetadataProviderMessage is the provider message object on metadata consumers.
+type MetadataProviderMessage struct {
+	Message string `json:"message,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+// MetadataOutput is the metadata consumer API resource shared by Sonarr, Radarr, Lidarr and Readarr.
+// Metadata consumers write files for media servers like Kodi, Emby and Plex.
+type MetadataOutput struct {
+	ID                 int64                    `json:"id,omitempty"`
+	Name               string                   `json:"name,omitempty"`
+	Fields             []*starr.FieldOutput     `json:"fields,omitempty"`
+	ImplementationName string                   `json:"implementationName,omitempty"`
+	Implementation     string                   `json:"implementation,omitempty"`
+	ConfigContract     string                   `json:"configContract,omitempty"`
+	InfoLink           string                   `json:"infoLink,omitempty"`
+	Message            *MetadataProviderMessage `json:"message,omitempty"`
+	Tags               []int                    `json:"tags,omitempty"`
+	Presets            []*MetadataOutput        `json:"presets,omitempty"`
+	Enable             bool                     `json:"enable"`
+}
+
+// ToInput converts the output of a metadata consumer into an input, so it can be changed and sent back.
+// Masked secrets in Fields are copied as-is; see starr.ResolveSecrets.
+func (m *MetadataOutput) ToInput() *MetadataInput {
+	if m == nil {
+		return nil
+	}
+
+	return &MetadataInput{
+		ID:             m.ID,
+		Name:           m.Name,
+		Fields:         starr.ToFieldInputs(m.Fields),
+		Implementation: m.Implementation,
+		ConfigContract: m.ConfigContract,
+		Tags:           m.Tags,
+		Enable:         m.Enable,
+	}
+}
+
+// MetadataInput is the input for creating or updating metadata consumers.
+type MetadataInput struct {
+	ID             int64               `json:"id,omitempty"`
+	Name           string              `json:"name,omitempty"`
+	Fields         []*starr.FieldInput `json:"fields,omitempty"`
+	Implementation string              `json:"implementation,omitempty"`
+	ConfigContract string              `json:"configContract,omitempty"`
+	Tags           []int               `json:"tags,omitempty"`
+	Enable         bool                `json:"enable"`
+}
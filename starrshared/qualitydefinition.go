@@ -0,0 +1,26 @@
+package starrshared
+
+// QualityDefinitionLimits are the lowest and highest values allowed in a quality definition's sizes,
+// from the /qualitydefinition/limits API resource in Radarr and Sonarr.
+type QualityDefinitionLimits struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+// QualitySize is the range of release sizes, in bytes, that a quality definition accepts for a runtime.
+type QualitySize struct {
+	Min       int64
+	Preferred int64 // 0 when the definition has no preferred size.
+	Max       int64 // 0 when unlimited.
+}
+
+// Accepts returns true if a release of this many bytes is inside the range.
+func (q *QualitySize) Accepts(size int64) bool {
+	return size >= q.Min && (q.Max == 0 || size <= q.Max)
+}
+
+// Bytes in one size unit of a quality definition.
+const (
+	Megabyte = 1024 * 1024 // Radarr and Sonarr sizes are megabytes per minute.
+	Kilobit  = 1024 / 8    // Lidarr sizes are kilobits per second.
+)
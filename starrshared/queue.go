@@ -0,0 +1,13 @@
+package starrshared
+
+// QueueStatus is the aggregate /queue/status API resource shared by all Starr app clients except Prowlarr.
+type QueueStatus struct {
+	ID              int  `json:"id,omitempty"`
+	TotalCount      int  `json:"totalCount,omitempty"`
+	Count           int  `json:"count,omitempty"`
+	UnknownCount    int  `json:"unknownCount,omitempty"`
+	Errors          bool `json:"errors"`
+	Warnings        bool `json:"warnings"`
+	UnknownErrors   bool `json:"unknownErrors"`
+	UnknownWarnings bool `json:"unknownWarnings"`
+}
@@ -0,0 +1,24 @@
+package starrshared
+
+import (
+	"time"
+
+	"golift.io/starr"
+)
+
+// PushRelease is the input for the /release/push endpoint shared by Sonarr, Lidarr, Readarr, and Radarr.
+// It is a release found outside of the app, like in an RSS feed or an IRC announce channel.
+// Title, DownloadURL (or MagnetURL), Protocol and PublishDate are required.
+type PushRelease struct {
+	Title            string         `json:"title"`
+	DownloadURL      string         `json:"downloadUrl,omitempty"`
+	MagnetURL        string         `json:"magnetUrl,omitempty"`
+	InfoHash         string         `json:"infoHash,omitempty"`
+	Protocol         starr.Protocol `json:"protocol"`
+	PublishDate      time.Time      `json:"publishDate"`
+	Indexer          string         `json:"indexer,omitempty"`
+	IndexerID        int64          `json:"indexerId,omitempty"`
+	Size             int64          `json:"size,omitempty"`
+	DownloadClientID int64          `json:"downloadClientId,omitempty"`
+	DownloadClient   string         `json:"downloadClient,omitempty"`
+}
@@ -0,0 +1,528 @@
+package starrsnap
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golift.io/starr"
+)
+
+// Action is the kind of change made to an item while applying a snapshot.
+type Action string
+
+// Actions that Apply may take.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	// ActionSkip is only used by SyncFormats, when a conflicting item is left alone.
+	ActionSkip Action = "skip"
+)
+
+// ApplyOptions control how a snapshot is applied. Nil options are the same as empty options.
+type ApplyOptions struct {
+	// Prune deletes items on the instance that are not in the snapshot.
+	// Only lists present in the snapshot are pruned. Tags are never pruned.
+	Prune bool
+	// ForceSave saves download clients, indexers and other providers without testing them first.
+	ForceSave bool
+	// DryRun plans the changes without making them. The report lists what Apply would do.
+	// Items that would be created get negative placeholder IDs, so references to them still resolve.
+	DryRun bool
+	// Secrets provides the real values of masked provider fields, like passwords and API keys.
+	// The apps return these as starr.MaskedValue, so a snapshot never has them. Creating or
+	// updating a provider with a masked value that Secrets cannot resolve returns an error.
+	Secrets starr.SecretResolver
+}
+
+// dryRun returns a copy of the options with DryRun set.
+func dryRun(opts *ApplyOptions) *ApplyOptions {
+	plan := ApplyOptions{}
+	if opts != nil {
+		plan = *opts
+	}
+
+	plan.DryRun = true
+
+	return &plan
+}
+
+// Change is one create, update or delete made (or planned) while applying a snapshot.
+type Change struct {
+	Action Action `json:"action"`
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	// Diff lists the fields an update changes, one `field: old -> new` per line.
+	Diff []string `json:"diff,omitempty"`
+}
+
+// Report lists the changes made (or planned) while applying a snapshot.
+type Report struct {
+	Changes []*Change `json:"changes"`
+}
+
+// String returns one line per change, followed by the indented diff of each update.
+func (r *Report) String() string {
+	var buf strings.Builder
+
+	for _, change := range r.Changes {
+		fmt.Fprintf(&buf, "%s %s '%s'\n", change.Action, change.Kind, change.Name)
+
+		for _, line := range change.Diff {
+			fmt.Fprintf(&buf, "    %s\n", line)
+		}
+	}
+
+	return buf.String()
+}
+
+func (r *Report) add(action Action, kind, name string, diff ...string) {
+	r.Changes = append(r.Changes, &Change{Action: action, Kind: kind, Name: name, Diff: diff})
+}
+
+// idMap translates IDs from the instance a snapshot was taken from into IDs on the target instance.
+type idMap map[int64]int64
+
+// get returns the target ID for a source ID of a kind of item. Zero returns zero.
+// An ID not in the map returns ErrUnresolvedID, because zero often means "all".
+func (m idMap) get(kind string, srcID int64) (int64, error) {
+	if srcID == 0 {
+		return 0, nil
+	}
+
+	dstID, ok := m[srcID]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s %d", ErrUnresolvedID, kind, srcID)
+	}
+
+	return dstID, nil
+}
+
+// tags translates a list of tag IDs. Unknown tags are dropped, and the output is sorted.
+func (m idMap) tags(src []int) []int {
+	if src == nil {
+		return nil
+	}
+
+	out := make([]int, 0, len(src))
+
+	for _, tag := range src {
+		if dst, ok := m[int64(tag)]; ok {
+			out = append(out, int(dst))
+		}
+	}
+
+	slices.Sort(out)
+
+	return out
+}
+
+// list reconciles one list of items in a snapshot with the same list on a live instance.
+// Items are matched by key, which is usually the item name.
+type list[T any] struct {
+	kind string
+	want []T
+	live []T
+	key  func(T) string
+	id   func(T) *int64
+	// remap translates IDs inside a wanted item into target instance IDs. Optional.
+	remap func(T)
+	// resolve translates the IDs of other items a wanted item points to, like an indexer's download client.
+	// It fails if a referenced item is not on the target instance. Optional.
+	resolve func(T) error
+	add     func(context.Context, T) (int64, error)
+	update  func(context.Context, T) error // nil when the app cannot update this kind.
+	remove  func(context.Context, int64) error
+	// fields returns a provider's name and fields, so masked secrets can be resolved. Optional.
+	fields func(T) (string, []*starr.FieldInput)
+	// keys is filled with the target ID of every item left on the instance, by key. Optional.
+	keys    map[string]int64
+	planned int64 // count of items created in a dry run.
+}
+
+// apply creates and updates wanted items, and deletes unwanted items if the options say so.
+// Returns a map of snapshot IDs to target instance IDs for every wanted item.
+func (l *list[T]) apply(ctx context.Context, opts *ApplyOptions, report *Report) (idMap, error) {
+	ids := make(idMap)
+	if l.want == nil {
+		for _, item := range l.live { // Nothing to apply; map the live IDs to themselves.
+			ids[*l.id(item)] = *l.id(item)
+			l.keep(l.key(item), *l.id(item))
+		}
+
+		return ids, nil
+	}
+
+	live := make(map[string]T, len(l.live))
+	for _, item := range l.live {
+		live[l.key(item)] = item
+	}
+
+	wanted := make(map[string]bool, len(l.want))
+
+	for _, src := range l.want {
+		item, err := clone(src)
+		if err != nil {
+			return nil, err
+		}
+
+		srcID := *l.id(item)
+		if l.remap != nil {
+			l.remap(item)
+		}
+
+		key := l.key(item)
+		wanted[key] = true
+
+		if l.resolve != nil {
+			if err := l.resolve(item); err != nil {
+				return nil, fmt.Errorf("%s '%s': %w", l.kind, key, err)
+			}
+		}
+
+		current, exists := live[key]
+		if !exists {
+			if ids[srcID], err = l.create(ctx, opts, item, key); err != nil {
+				return nil, err
+			}
+
+			report.add(ActionCreate, l.kind, key)
+			l.keep(key, ids[srcID])
+
+			continue
+		}
+
+		ids[srcID] = *l.id(current)
+		*l.id(item) = *l.id(current)
+		l.keep(key, ids[srcID])
+
+		if l.update == nil || equal(item, current) {
+			continue
+		}
+
+		changes := diff(current, item) // Before secrets are resolved, so they are not in the report.
+		if err := l.change(ctx, opts, item, key); err != nil {
+			return nil, err
+		}
+
+		report.add(ActionUpdate, l.kind, key, changes...)
+	}
+
+	return ids, l.prune(ctx, opts, wanted, report)
+}
+
+func (l *list[T]) create(ctx context.Context, opts *ApplyOptions, item T, key string) (int64, error) {
+	*l.id(item) = 0
+
+	if err := l.secrets(opts, item, key); err != nil {
+		return 0, err
+	}
+
+	if opts.DryRun {
+		l.planned++
+		return -l.planned, nil
+	}
+
+	id, err := l.add(ctx, item)
+	if err != nil {
+		return 0, fmt.Errorf("adding %s '%s': %w", l.kind, key, err)
+	}
+
+	return id, nil
+}
+
+func (l *list[T]) change(ctx context.Context, opts *ApplyOptions, item T, key string) error {
+	if err := l.secrets(opts, item, key); err != nil || opts.DryRun {
+		return err
+	}
+
+	if err := l.update(ctx, item); err != nil {
+		return fmt.Errorf("updating %s '%s': %w", l.kind, key, err)
+	}
+
+	return nil
+}
+
+// secrets resolves masked field values before an item is written. A dry run checks them too.
+func (l *list[T]) secrets(opts *ApplyOptions, item T, key string) error {
+	if l.fields == nil {
+		return nil
+	}
+
+	name, fields := l.fields(item)
+	if err := starr.ResolveSecrets(name, fields, opts.Secrets); err != nil {
+		return fmt.Errorf("%s '%s': %w", l.kind, key, err)
+	}
+
+	return nil
+}
+
+func (l *list[T]) prune(ctx context.Context, opts *ApplyOptions, wanted map[string]bool, report *Report) error {
+	for _, item := range l.live {
+		key := l.key(item)
+		if wanted[key] {
+			continue
+		}
+
+		if !opts.Prune {
+			l.keep(key, *l.id(item))
+			continue
+		}
+
+		if !opts.DryRun {
+			if err := l.remove(ctx, *l.id(item)); err != nil {
+				return fmt.Errorf("deleting %s '%s': %w", l.kind, key, err)
+			}
+		}
+
+		report.add(ActionDelete, l.kind, key)
+	}
+
+	return nil
+}
+
+func (l *list[T]) keep(key string, id int64) {
+	if l.keys != nil {
+		l.keys[key] = id
+	}
+}
+
+// applyOne updates a singleton resource, like naming or media management, if it changed.
+func applyOne[T any](ctx context.Context, kind string, want, live *T, id func(*T) *int64,
+	update func(context.Context, *T) error, opts *ApplyOptions, report *Report,
+) error {
+	if want == nil || live == nil {
+		return nil
+	}
+
+	item, err := clone(want)
+	if err != nil {
+		return err
+	}
+
+	*id(item) = *id(live)
+	if equal(item, live) {
+		return nil
+	}
+
+	if !opts.DryRun {
+		if err := update(ctx, item); err != nil {
+			return fmt.Errorf("updating %s: %w", kind, err)
+		}
+	}
+
+	report.add(ActionUpdate, kind, kind, diff(live, item)...)
+
+	return nil
+}
+
+// applyTags makes sure every tag label in the snapshot exists on the instance.
+// Returns a map of snapshot tag IDs to instance tag IDs, and a map of instance tag IDs to labels.
+func applyTags(ctx context.Context, want, live []*starr.Tag,
+	add func(context.Context, *starr.Tag) (*starr.Tag, error), opts *ApplyOptions, report *Report,
+) (idMap, map[int]string, error) {
+	ids := make(idMap)
+	labels := make(map[int]string)
+	byLabel := make(map[string]int)
+
+	for _, tag := range live {
+		labels[tag.ID] = tag.Label
+		byLabel[strings.ToLower(tag.Label)] = tag.ID
+		ids[int64(tag.ID)] = int64(tag.ID)
+	}
+
+	if want == nil {
+		return ids, labels, nil
+	}
+
+	clear(ids) // IDs in the snapshot are not live IDs.
+
+	for _, tag := range want {
+		if id, ok := byLabel[strings.ToLower(tag.Label)]; ok {
+			ids[int64(tag.ID)] = int64(id)
+			continue
+		}
+
+		created := &starr.Tag{ID: -len(ids) - 1, Label: tag.Label} // dry run placeholder.
+		if !opts.DryRun {
+			var err error
+			if created, err = add(ctx, &starr.Tag{Label: tag.Label}); err != nil {
+				return nil, nil, fmt.Errorf("adding tag '%s': %w", tag.Label, err)
+			}
+		}
+
+		report.add(ActionCreate, "tag", tag.Label)
+		ids[int64(tag.ID)] = int64(created.ID)
+		labels[created.ID] = created.Label
+		byLabel[strings.ToLower(created.Label)] = created.ID
+	}
+
+	return ids, labels, nil
+}
+
+// tagKey turns a list of tag IDs into a name. Used for items without names, like delay profiles.
+func tagKey(tags []int, labels map[int]string) string {
+	if len(tags) == 0 {
+		return "(no tags)"
+	}
+
+	names := make([]string, len(tags))
+	for idx, tag := range tags {
+		if names[idx] = labels[tag]; names[idx] == "" {
+			names[idx] = starr.Str(tag)
+		}
+	}
+
+	slices.Sort(names)
+
+	return strings.Join(names, ",")
+}
+
+// termsKey turns a release profile's terms and tags into a name. Used for release profiles without names.
+func termsKey(required, ignored []string, tags []int, labels map[int]string) string {
+	return fmt.Sprintf("required: %s; ignored: %s; tags: %s", strings.Join(slices.Sorted(slices.Values(required)), ","),
+		strings.Join(slices.Sorted(slices.Values(ignored)), ","), tagKey(tags, labels))
+}
+
+// formatItems makes a quality profile's custom format scores point to the custom formats
+// on the target instance by name. Formats missing from the profile are added with a score
+// of zero, because the apps require every custom format to be present in every profile.
+// A score for a custom format that is not on the target instance returns ErrUnresolvedID.
+func formatItems(items []*starr.FormatItem, formats map[string]int64) ([]*starr.FormatItem, error) {
+	if len(items) == 0 && len(formats) == 0 {
+		return items, nil
+	}
+
+	out := make([]*starr.FormatItem, 0, len(formats))
+	seen := make(map[string]bool, len(formats))
+
+	for _, item := range items {
+		id, ok := formats[item.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: custom format %d (%s)", ErrUnresolvedID, item.Format, item.Name)
+		}
+
+		if !seen[item.Name] {
+			seen[item.Name] = true
+			out = append(out, &starr.FormatItem{Format: id, Name: item.Name, Score: item.Score})
+		}
+	}
+
+	for name, id := range formats {
+		if !seen[name] {
+			out = append(out, &starr.FormatItem{Format: id, Name: name})
+		}
+	}
+
+	sortFormatItems(out)
+
+	return out, nil
+}
+
+func sortFormatItems(items []*starr.FormatItem) {
+	slices.SortFunc(items, func(a, b *starr.FormatItem) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+}
+
+// sortBy sorts a list in place with a key function, and returns it. Nil lists become empty.
+func sortBy[T any, K cmp.Ordered](items []T, key func(T) K) []T {
+	if items == nil {
+		return []T{}
+	}
+
+	slices.SortStableFunc(items, func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	})
+
+	return items
+}
+
+// convert turns one list type into another with the same json tags.
+func convert[I, O any](outputs []O) ([]I, error) {
+	inputs := make([]I, len(outputs))
+
+	for idx, output := range outputs {
+		var err error
+		if inputs[idx], err = recast[I](output); err != nil {
+			return nil, err
+		}
+	}
+
+	return inputs, nil
+}
+
+// inputs converts a list of app outputs into inputs with their ToInput method.
+func inputs[O, I any](outputs []O, toInput func(O) I) []I {
+	output := make([]I, len(outputs))
+	for idx, item := range outputs {
+		output[idx] = toInput(item)
+	}
+
+	return output
+}
+
+// recast turns one type into another with the same json tags.
+func recast[O, I any](input I) (O, error) {
+	var output O
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return output, fmt.Errorf("encoding %T: %w", input, err)
+	}
+
+	if err := json.Unmarshal(data, &output); err != nil {
+		return output, fmt.Errorf("decoding %T: %w", output, err)
+	}
+
+	return output, nil
+}
+
+// clone returns a deep copy of an item, so applying a snapshot never changes it.
+func clone[T any](item T) (T, error) {
+	var out T
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		return out, fmt.Errorf("copying %T: %w", item, err)
+	}
+
+	if err := json.Unmarshal(data, &out); err != nil {
+		return out, fmt.Errorf("copying %T: %w", item, err)
+	}
+
+	return out, nil
+}
+
+// equal reports whether two items encode to the same json.
+func equal(a, b any) bool {
+	dataA, errA := json.Marshal(a)
+	dataB, errB := json.Marshal(b)
+
+	return errA == nil && errB == nil && bytes.Equal(dataA, dataB)
+}
+
+// created wraps an Add method, so it returns the new item's ID instead of the item.
+func created[O any](id func(O) int64) func(O, error) (int64, error) {
+	return func(output O, err error) (int64, error) {
+		if err != nil {
+			return 0, err
+		}
+
+		return id(output), nil
+	}
+}
+
+// mappingKey names a remote path mapping, which has no name of its own.
+func mappingKey(m *starr.RemotePathMapping) string {
+	return m.Host + ":" + m.RemotePath
+}
+
+func mappingID(m *starr.RemotePathMapping) int64 {
+	return m.ID
+}
@@ -0,0 +1,271 @@
+package starrsnap_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrsnap"
+	"golift.io/starr/starrtest"
+)
+
+// liveRoutes returns GET routes for an instance with one tag, one quality profile, a default
+// delay profile and one download client. Every other list is empty.
+func liveRoutes(base string) map[string][]string {
+	return map[string][]string{
+		"GET " + base + "/tag":            {`[{"id":1,"label":"hd"}]`},
+		"GET " + base + "/customFormat":   {`[]`},
+		"GET " + base + "/customformat":   {`[]`},
+		"GET " + base + "/qualityProfile": {`[{"id":4,"name":"HD","upgradeAllowed":true,"cutoff":7,"items":[]}]`},
+		"GET " + base + "/delayProfile":   {`[{"id":1,"enableUsenet":true,"order":2147483647,"tags":[]}]`},
+		"GET " + base + "/releaseProfile": {`[]`},
+		"GET " + base + "/downloadClient": {`[{"id":2,"name":"sab","enable":true,"priority":1,"tags":[1],` +
+			`"implementation":"Sabnzbd","fields":[{"name":"host","value":"localhost"}]}]`},
+		"GET " + base + "/indexer":                {`[]`},
+		"GET " + base + "/notification":           {`[]`},
+		"GET " + base + "/config/naming":          {`{"id":1,"renameEpisodes":true}`},
+		"GET " + base + "/config/mediaManagement": {`{"id":1,"recycleBin":"/trash"}`},
+		"GET " + base + "/rootFolder":             {`[]`},
+		"GET " + base + "/remotePathMapping":      {`[]`},
+	}
+}
+
+func TestSonarrPlan(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewMockServer(t, liveRoutes("/api/v3"))
+	snap := &starrsnap.Sonarr{
+		Tags: []*starr.Tag{{ID: 7, Label: "hd"}, {ID: 8, Label: "anime"}},
+		QualityProfiles: []*sonarr.QualityProfile{
+			{ID: 9, Name: "HD", UpgradeAllowed: true, Cutoff: 8, Qualities: []*starr.Quality{}},
+		},
+		DownloadClients: []*sonarr.DownloadClientInput{{
+			ID: 3, Name: "sab", Enable: true, Priority: 1, Tags: []int{7, 8}, Implementation: "Sabnzbd",
+			Fields: []*starr.FieldInput{{Name: "host", Value: "localhost"}},
+		}},
+		Indexers: []*sonarr.IndexerInput{{ID: 5, Name: "nzb", DownloadClientID: 3}},
+	}
+
+	report, err := snap.Plan(t.Context(), sonarr.New(starr.New("apikey", fake.URL, 0)), nil)
+	require.NoError(t, err)
+	assert.Empty(t, writes(fake), "a plan must not change anything")
+	// The new tag gets a placeholder ID, and the indexer's download client resolves to the live client.
+	assert.Equal(t, `create tag 'anime'
+update quality profile 'HD'
+    cutoff: 7 -> 8
+update download client 'sab'
+    tags: [1] -> [-2,1]
+create indexer 'nzb'
+`, report.String())
+}
+
+func TestLidarrPlan(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewMockServer(t, liveRoutes("/api/v1"))
+	snap := &starrsnap.Lidarr{
+		Tags:            []*starr.Tag{{ID: 7, Label: "flac"}},
+		QualityProfiles: []*lidarr.QualityProfile{},
+		DownloadClients: []*lidarr.DownloadClientInput{{
+			ID: 3, Name: "sab", Enable: true, Priority: 1, Tags: []int{7}, Implementation: "Sabnzbd",
+			Fields: []*starr.FieldInput{{Name: "host", Value: "sab"}},
+		}},
+	}
+
+	app := lidarr.New(starr.New("apikey", fake.URL, 0))
+
+	report, err := snap.Plan(t.Context(), app, &starrsnap.ApplyOptions{Prune: true})
+	require.NoError(t, err)
+	assert.Empty(t, writes(fake), "a plan must not change anything")
+	assert.Equal(t, `create tag 'flac'
+delete quality profile 'HD'
+update download client 'sab'
+    fields[host].value: "localhost" -> "sab"
+    tags: [1] -> [-1]
+`, report.String())
+}
+
+func TestReadarrPlan(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewMockServer(t, liveRoutes("/api/v1"))
+	snap := &starrsnap.Readarr{
+		DelayProfiles: []*readarr.DelayProfile{
+			{ID: 1, EnableUsenet: true, EnableTorrent: true, Order: 2147483647, Tags: []int{}},
+		},
+		DownloadClients: []*readarr.DownloadClientInput{},
+		Indexers:        []*readarr.IndexerInput{{ID: 5, Name: "nzb", Priority: 25}},
+	}
+
+	app := readarr.New(starr.New("apikey", fake.URL, 0))
+
+	report, err := snap.Plan(t.Context(), app, &starrsnap.ApplyOptions{Prune: true})
+	require.NoError(t, err)
+	assert.Empty(t, writes(fake), "a plan must not change anything")
+	assert.Equal(t, `update delay profile '(no tags)'
+    enableTorrent: (none) -> true
+delete download client 'sab'
+create indexer 'nzb'
+`, report.String())
+}
+
+func TestProwlarrPlan(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewMockServer(t, map[string][]string{
+		"GET /api/v1/tag":        {`[]`},
+		"GET /api/v1/appprofile": {`[{"id":1,"name":"Standard","enableRss":true}]`},
+		"GET /api/v1/applications": {`[{"id":1,"name":"Movies","syncLevel":"fullSync","appProfileId":1,` +
+			`"implementation":"Radarr","fields":[{"name":"baseUrl","value":"http://movies:7878"}]}]`},
+		"GET /api/v1/downloadClient": {`[]`},
+		"GET /api/v1/indexer":        {`[]`},
+		"GET /api/v1/notification":   {`[]`},
+	})
+	snap := &starrsnap.Prowlarr{
+		Tags: []*starr.Tag{{ID: 3, Label: "anime"}},
+		AppProfiles: []*prowlarr.AppProfile{
+			{ID: 5, Name: "Anime", EnableRss: true},
+			{ID: 6, Name: "Standard", EnableRss: true},
+		},
+		Applications: []*prowlarr.ApplicationInput{
+			{
+				ID: 8, Name: "Movies", SyncLevel: "addOnly", AppProfileID: 6, Implementation: "Radarr",
+				Fields: []*starr.FieldInput{{Name: "baseUrl", Value: "http://movies:7878"}},
+			},
+			{
+				ID: 9, Name: "Anime", SyncLevel: "fullSync", AppProfileID: 5, Implementation: "Sonarr", Tags: []int{3},
+				Fields: []*starr.FieldInput{{Name: "baseUrl", Value: "http://anime:8989"}},
+			},
+		},
+	}
+
+	report, err := snap.Plan(t.Context(), prowlarr.New(starr.New("apikey", fake.URL, 0)), nil)
+	require.NoError(t, err)
+	assert.Empty(t, writes(fake), "a plan must not change anything")
+	assert.Equal(t, `create tag 'anime'
+create app profile 'Anime'
+update application 'Movies'
+    syncLevel: "fullSync" -> "addOnly"
+create application 'Anime'
+`, report.String())
+}
+
+func TestReadarrApply(t *testing.T) {
+	t.Parallel()
+
+	routes := liveRoutes("/api/v1")
+	routes["GET /api/v1/customformat"] = []string{`[{"id":3,"name":"Retail","specifications":[]}]`}
+	routes["GET /api/v1/qualityProfile"] = []string{`[{"id":4,"name":"HD","upgradeAllowed":true,"cutoff":7,` +
+		`"items":[],"formatItems":[{"format":3,"name":"Retail","score":0}]}]`}
+	routes["POST /api/v1/customformat"] = []string{`{"id":11,"name":"Audiobook"}`}
+	routes["PUT /api/v1/qualityProfile/4"] = []string{`{"id":4,"name":"HD"}`}
+	fake := starrtest.NewMockServer(t, routes)
+	app := readarr.New(starr.New("apikey", fake.URL, 0))
+	// IDs in this snapshot come from another instance.
+	snap := &starrsnap.Readarr{
+		CustomFormats: []*readarr.CustomFormatInput{
+			{ID: 20, Name: "Retail", Specifications: []*readarr.CustomFormatInputSpec{}},
+			{ID: 21, Name: "Audiobook", Specifications: []*readarr.CustomFormatInputSpec{}},
+		},
+		QualityProfiles: []*readarr.QualityProfile{{
+			ID: 8, Name: "HD", UpgradeAllowed: true, Cutoff: 7, Qualities: []*starr.Quality{},
+			FormatItems: []*starr.FormatItem{{Format: 20, Name: "Retail", Score: 10}, {Format: 21, Name: "Audiobook", Score: 5}},
+		}},
+	}
+
+	_, err := snap.Apply(t.Context(), app, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"POST /api/v1/customformat", "PUT /api/v1/qualityProfile/4"}, writes(fake))
+	assert.Contains(t, fake.Last("PUT /api/v1/qualityProfile/4").Body,
+		`"formatItems":[{"format":11,"name":"Audiobook","score":5},{"format":3,"name":"Retail","score":10}]`,
+		"custom format IDs must be remapped by name")
+
+	snap = &starrsnap.Readarr{QualityProfiles: []*readarr.QualityProfile{{
+		ID: 8, Name: "HD", FormatItems: []*starr.FormatItem{{Format: 22, Name: "Abridged", Score: -50}},
+	}}}
+
+	_, err = snap.Apply(t.Context(), app, nil)
+	require.ErrorIs(t, err, starrsnap.ErrUnresolvedID)
+	assert.ErrorContains(t, err, "custom format 22 (Abridged)")
+}
+
+// releaseProfileRoutes returns the routes of a Lidarr or Readarr instance with one indexer and one release profile.
+func releaseProfileRoutes() map[string][]string {
+	routes := liveRoutes("/api/v1")
+	routes["GET /api/v1/indexer"] = []string{`[{"id":6,"name":"nzb"}]`}
+	routes["GET /api/v1/releaseProfile"] = []string{`[{"id":2,"enabled":true,"required":["flac"],"ignored":[],"tags":[1]}]`}
+	routes["POST /api/v1/releaseProfile"] = []string{`{"id":12}`}
+	routes["DELETE /api/v1/releaseProfile/2"] = []string{``}
+
+	return routes
+}
+
+func TestLidarrApplyReleaseProfiles(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewMockServer(t, releaseProfileRoutes())
+	app := lidarr.New(starr.New("apikey", fake.URL, 0))
+	// IDs in this snapshot come from another instance.
+	snap := &starrsnap.Lidarr{
+		Tags:     []*starr.Tag{{ID: 7, Label: "hd"}},
+		Indexers: []*lidarr.IndexerInput{{ID: 30, Name: "nzb"}},
+		ReleaseProfiles: []*lidarr.ReleaseProfile{
+			{ID: 9, Enabled: true, Required: []string{"flac"}, Ignored: []string{}, Tags: []int{7}},
+			{ID: 10, Enabled: true, Required: []string{"320"}, IndexerID: 30, Tags: []int{}},
+		},
+	}
+
+	report, err := snap.Apply(t.Context(), app, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "create release profile 'required: 320; ignored: ; tags: (no tags)'\n", report.String())
+	assert.Equal(t, []string{"POST /api/v1/releaseProfile"}, writes(fake))
+	assert.Contains(t, fake.Last("POST /api/v1/releaseProfile").Body, `"indexerId":6`, "indexer IDs must be remapped by name")
+
+	snap.ReleaseProfiles[1].IndexerID = 31
+	_, err = snap.Apply(t.Context(), app, nil)
+	require.ErrorIs(t, err, starrsnap.ErrUnresolvedID, "a missing indexer must not become 'any indexer'")
+
+	snap = &starrsnap.Lidarr{ReleaseProfiles: []*lidarr.ReleaseProfile{}}
+	report, err = snap.Apply(t.Context(), app, &starrsnap.ApplyOptions{Prune: true})
+	require.NoError(t, err)
+	assert.Equal(t, "delete release profile 'required: flac; ignored: ; tags: hd'\n", report.String())
+	assert.Equal(t, 1, fake.Count("DELETE /api/v1/releaseProfile/2"))
+}
+
+func TestReadarrApplyReleaseProfiles(t *testing.T) {
+	t.Parallel()
+
+	fake := starrtest.NewMockServer(t, releaseProfileRoutes())
+	app := readarr.New(starr.New("apikey", fake.URL, 0))
+	// IDs in this snapshot come from another instance.
+	snap := &starrsnap.Readarr{
+		Tags:     []*starr.Tag{{ID: 7, Label: "hd"}},
+		Indexers: []*readarr.IndexerInput{{ID: 30, Name: "nzb"}},
+		ReleaseProfiles: []*readarr.ReleaseProfile{
+			{ID: 9, Enabled: true, Required: []string{"flac"}, Ignored: []string{}, Tags: []int{7}},
+			{ID: 10, Enabled: true, Required: []string{"epub"}, Ignored: []string{"pdf"}, IndexerID: 30, Tags: []int{7}},
+		},
+	}
+
+	report, err := snap.Apply(t.Context(), app, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "create release profile 'required: epub; ignored: pdf; tags: hd'\n", report.String())
+	assert.Equal(t, []string{"POST /api/v1/releaseProfile"}, writes(fake))
+	assert.Contains(t, fake.Last("POST /api/v1/releaseProfile").Body, `"indexerId":6,"tags":[1]`,
+		"indexer and tag IDs must be remapped by name")
+
+	snap.ReleaseProfiles[1].IndexerID = 31
+	_, err = snap.Apply(t.Context(), app, nil)
+	require.ErrorIs(t, err, starrsnap.ErrUnresolvedID, "a missing indexer must not become 'any indexer'")
+
+	snap = &starrsnap.Readarr{ReleaseProfiles: []*readarr.ReleaseProfile{}}
+	report, err = snap.Apply(t.Context(), app, &starrsnap.ApplyOptions{Prune: true})
+	require.NoError(t, err)
+	assert.Equal(t, "delete release profile 'required: flac; ignored: ; tags: hd'\n", report.String())
+	assert.Equal(t, 1, fake.Count("DELETE /api/v1/releaseProfile/2"))
+}
@@ -0,0 +1,106 @@
+package starrsnap
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// diff compares two versions of an item, and returns one `field: old -> new` line per changed field.
+// Nested fields are joined with dots. List elements with a name use the name as their index,
+// so `fields[apiKey].value` is easier to read than `fields[3].value`.
+func diff(before, after any) []string {
+	old, changed := flatten(before), flatten(after)
+	both := maps.Clone(old)
+	maps.Copy(both, changed)
+
+	lines := []string{}
+
+	for _, key := range slices.Sorted(maps.Keys(both)) {
+		oldVal, ok := old[key]
+		if !ok {
+			oldVal = "(none)"
+		}
+
+		newVal, ok := changed[key]
+		if !ok {
+			newVal = "(none)"
+		}
+
+		if oldVal != newVal {
+			lines = append(lines, key+": "+oldVal+" -> "+newVal)
+		}
+	}
+
+	return lines
+}
+
+// flatten turns an item into a map of field paths to json values.
+func flatten(item any) map[string]string {
+	out := make(map[string]string)
+
+	data, err := json.Marshal(item)
+	if err != nil {
+		return out
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return out
+	}
+
+	flattenInto(out, "", doc)
+
+	return out
+}
+
+func flattenInto(out map[string]string, path string, val any) {
+	switch val := val.(type) {
+	case map[string]any:
+		for key, child := range val {
+			if path != "" {
+				key = path + "." + key
+			}
+
+			flattenInto(out, key, child)
+		}
+	case []any:
+		if !slices.ContainsFunc(val, isCollection) {
+			data, _ := json.Marshal(val) // lists of numbers and strings, like tags, read best as one value.
+			out[path] = string(data)
+
+			return
+		}
+
+		for idx, child := range val {
+			flattenInto(out, path+"["+elementName(child, idx)+"]", child)
+		}
+	default:
+		data, _ := json.Marshal(val)
+		out[path] = string(data)
+	}
+}
+
+func isCollection(val any) bool {
+	switch val.(type) {
+	case map[string]any, []any:
+		return true
+	default:
+		return false
+	}
+}
+
+func elementName(val any, idx int) string {
+	if obj, ok := val.(map[string]any); ok {
+		if name, ok := obj["name"].(string); ok && name != "" {
+			return name
+		}
+	}
+
+	return strconv.Itoa(idx)
+}
@@ -0,0 +1,421 @@
+package starrsnap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/sonarr"
+)
+
+// Policy decides what SyncFormats does when a target already has a custom format
+// with the same name as a source format, but a different definition.
+type Policy string
+
+// Policies for SyncFormats. An empty policy is the same as PolicyOverwrite.
+const (
+	// PolicyOverwrite replaces the target's custom format with the source's.
+	PolicyOverwrite Policy = "overwrite"
+	// PolicySkip keeps the target's custom format. Scores are still synced to it.
+	PolicySkip Policy = "skip"
+	// PolicyRename adds the source's custom format next to the target's, with a suffix on its name.
+	PolicyRename Policy = "rename"
+)
+
+// DefaultSuffix is appended to renamed custom formats when SyncOptions.Suffix is empty.
+const DefaultSuffix = " (synced)"
+
+// ErrPolicy is returned by SyncFormats when the options contain an unknown policy.
+var ErrPolicy = errors.New("unknown sync policy")
+
+// SyncOptions control SyncFormats. Nil options are the same as empty options.
+type SyncOptions struct {
+	// Policy decides what happens to conflicting custom formats.
+	Policy Policy
+	// Suffix is appended to the name of custom formats added with PolicyRename.
+	Suffix string
+	// Profiles limits score syncing to these quality profile names.
+	// Empty syncs every quality profile found on both instances.
+	Profiles []string
+	// DryRun plans the changes without making them. The reports list what SyncFormats would do.
+	DryRun bool
+}
+
+// FormatApp is a Radarr or Sonarr instance that custom formats can be synced from or to.
+// Create one with RadarrFormats or SonarrFormats.
+type FormatApp struct {
+	formats      func(context.Context) ([]*customFormat, error)
+	addFormat    func(context.Context, *customFormat) (int64, error)
+	updateFormat func(context.Context, *customFormat) error
+	profiles     func(context.Context) ([]*formatProfile, error)
+}
+
+// customFormat holds a Radarr or Sonarr custom format. Both apps use the same json.
+type customFormat struct {
+	ID                    int64         `json:"id,omitempty"`
+	Name                  string        `json:"name"`
+	IncludeCFWhenRenaming bool          `json:"includeCustomFormatWhenRenaming"`
+	Specifications        []*formatSpec `json:"specifications"`
+}
+
+type formatSpec struct {
+	Name           string              `json:"name"`
+	Implementation string              `json:"implementation"`
+	Negate         bool                `json:"negate"`
+	Required       bool                `json:"required"`
+	Fields         []*starr.FieldInput `json:"fields"`
+}
+
+// formatProfile points into an app's quality profile, so its scores can be changed and saved.
+type formatProfile struct {
+	name            string
+	items           *[]*starr.FormatItem
+	minScore        *int64
+	minUpgradeScore *int64
+	cutoffScore     *int64
+	save            func(context.Context) error
+}
+
+// profileScores are the custom format scores in a quality profile that SyncFormats copies.
+type profileScores struct {
+	FormatItems           []*starr.FormatItem `json:"formatItems"`
+	MinFormatScore        int64               `json:"minFormatScore"`
+	MinUpgradeFormatScore int64               `json:"minUpgradeFormatScore"`
+	CutoffFormatScore     int64               `json:"cutoffFormatScore"`
+}
+
+// RadarrFormats wraps a Radarr instance for SyncFormats.
+func RadarrFormats(app *radarr.Radarr) *FormatApp {
+	return &FormatApp{
+		formats: func(ctx context.Context) ([]*customFormat, error) {
+			formats, err := app.GetCustomFormatsContext(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("getting custom formats: %w", err)
+			}
+
+			return convert[*customFormat](formats)
+		},
+		addFormat: func(ctx context.Context, format *customFormat) (int64, error) {
+			input, err := recast[*radarr.CustomFormatInput](format)
+			if err != nil {
+				return 0, err
+			}
+
+			return created(func(o *radarr.CustomFormatOutput) int64 { return o.ID })(app.AddCustomFormatContext(ctx, input))
+		},
+		updateFormat: func(ctx context.Context, format *customFormat) error {
+			input, err := recast[*radarr.CustomFormatInput](format)
+			if err == nil {
+				_, err = app.UpdateCustomFormatContext(ctx, input)
+			}
+
+			return err
+		},
+		profiles: func(ctx context.Context) ([]*formatProfile, error) {
+			profiles, err := app.GetQualityProfilesContext(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("getting quality profiles: %w", err)
+			}
+
+			output := make([]*formatProfile, len(profiles))
+			for idx, profile := range profiles {
+				output[idx] = &formatProfile{
+					name: profile.Name, items: &profile.FormatItems, minScore: &profile.MinFormatScore,
+					minUpgradeScore: &profile.MinUpgradeFormatScore, cutoffScore: &profile.CutoffFormatScore,
+					save: func(ctx context.Context) error {
+						_, err := app.UpdateQualityProfileContext(ctx, profile)
+						return err
+					}}
+			}
+
+			return output, nil
+		},
+	}
+}
+
+// SonarrFormats wraps a Sonarr v4 instance for SyncFormats. Sonarr v3 has no custom formats.
+func SonarrFormats(app *sonarr.Sonarr) *FormatApp {
+	return &FormatApp{
+		formats: func(ctx context.Context) ([]*customFormat, error) {
+			formats, err := app.GetCustomFormatsContext(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("getting custom formats: %w", err)
+			}
+
+			return convert[*customFormat](formats)
+		},
+		addFormat: func(ctx context.Context, format *customFormat) (int64, error) {
+			input, err := recast[*sonarr.CustomFormatInput](format)
+			if err != nil {
+				return 0, err
+			}
+
+			return created(func(o *sonarr.CustomFormatOutput) int64 { return o.ID })(app.AddCustomFormatContext(ctx, input))
+		},
+		updateFormat: func(ctx context.Context, format *customFormat) error {
+			input, err := recast[*sonarr.CustomFormatInput](format)
+			if err == nil {
+				_, err = app.UpdateCustomFormatContext(ctx, input)
+			}
+
+			return err
+		},
+		profiles: func(ctx context.Context) ([]*formatProfile, error) {
+			profiles, err := app.GetQualityProfilesContext(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("getting quality profiles: %w", err)
+			}
+
+			output := make([]*formatProfile, len(profiles))
+			for idx, profile := range profiles {
+				output[idx] = &formatProfile{
+					name: profile.Name, items: &profile.FormatItems, minScore: &profile.MinFormatScore,
+					minUpgradeScore: &profile.MinUpgradeFormatScore, cutoffScore: &profile.CutoffFormatScore,
+					save: func(ctx context.Context) error {
+						_, err := app.UpdateQualityProfileContext(ctx, profile)
+						return err
+					}}
+			}
+
+			return output, nil
+		},
+	}
+}
+
+// SyncFormats copies custom formats, and the custom format scores in quality profiles,
+// from a source instance to each target. The scores include each profile's minimum,
+// minimum upgrade and cutoff format scores. Formats are matched by name, and the format IDs
+// in each target's quality profile format items are remapped to the target's formats.
+// Quality profiles are matched by name too; profiles missing on a target are not created.
+// Radarr and Sonarr can be mixed, but formats using a specification that only exists
+// in the source app are rejected by the target.
+//
+// Returns one report per target, in the same order as the targets.
+// The reports are returned with an error too, and list the changes made before the error.
+func SyncFormats(ctx context.Context, opts *SyncOptions, source *FormatApp, targets ...*FormatApp) ([]*Report, error) {
+	if opts == nil {
+		opts = &SyncOptions{}
+	}
+
+	switch opts.Policy {
+	case "", PolicyOverwrite, PolicySkip, PolicyRename:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrPolicy, opts.Policy)
+	}
+
+	formats, err := source.formats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+
+	profiles, err := source.profiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+
+	reports := make([]*Report, 0, len(targets))
+
+	for idx, target := range targets {
+		sync := &formatSync{
+			opts:   opts,
+			target: target,
+			report: &Report{},
+			names:  make(map[string]string),
+			ids:    make(map[string]int64),
+		}
+		reports = append(reports, sync.report)
+
+		if err := sync.run(ctx, formats, profiles); err != nil {
+			return reports, fmt.Errorf("target %d: %w", idx+1, err)
+		}
+	}
+
+	return reports, nil
+}
+
+// formatSync holds the state of one target being synced.
+type formatSync struct {
+	opts    *SyncOptions
+	target  *FormatApp
+	report  *Report
+	names   map[string]string // source format name -> target format name.
+	ids     map[string]int64  // target format name -> target format ID.
+	planned int64             // count of formats created in a dry run.
+}
+
+func (s *formatSync) run(ctx context.Context, formats []*customFormat, profiles []*formatProfile) error {
+	live, err := s.target.formats(ctx)
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]*customFormat, len(live))
+	for _, format := range live {
+		byName[format.Name] = format
+		s.ids[format.Name] = format.ID
+	}
+
+	for _, format := range formats {
+		if err := s.format(ctx, format, byName); err != nil {
+			return err
+		}
+	}
+
+	// Profiles are fetched after the formats are synced, because the
+	// apps add every new custom format to every profile with a score of zero.
+	targets, err := s.target.profiles(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, profile := range profiles {
+		if len(s.opts.Profiles) > 0 && !slices.Contains(s.opts.Profiles, profile.name) {
+			continue
+		}
+
+		idx := slices.IndexFunc(targets, func(p *formatProfile) bool { return p.name == profile.name })
+		if idx == -1 {
+			continue
+		}
+
+		if err := s.profile(ctx, profile, targets[idx]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *formatSync) format(ctx context.Context, src *customFormat, live map[string]*customFormat) error {
+	item, err := clone(src)
+	if err != nil {
+		return err
+	}
+
+	current, exists := live[item.Name]
+	if exists {
+		if item.ID = current.ID; !equal(item, current) {
+			switch s.opts.Policy {
+			case PolicySkip:
+				s.names[src.Name] = item.Name
+				s.report.add(ActionSkip, "custom format", item.Name)
+
+				return nil
+			case PolicyRename:
+				item.Name += s.suffix()
+				current, exists = live[item.Name]
+			case "", PolicyOverwrite:
+			}
+		}
+	}
+
+	s.names[src.Name] = item.Name
+
+	if !exists {
+		return s.create(ctx, item)
+	}
+
+	if item.ID = current.ID; equal(item, current) {
+		return nil
+	}
+
+	if !s.opts.DryRun {
+		if err := s.target.updateFormat(ctx, item); err != nil {
+			return fmt.Errorf("updating custom format '%s': %w", item.Name, err)
+		}
+	}
+
+	s.report.add(ActionUpdate, "custom format", item.Name, diff(current, item)...)
+
+	return nil
+}
+
+func (s *formatSync) create(ctx context.Context, item *customFormat) error {
+	item.ID = 0
+
+	if s.opts.DryRun {
+		s.planned++
+		s.ids[item.Name] = -s.planned
+	} else {
+		id, err := s.target.addFormat(ctx, item)
+		if err != nil {
+			return fmt.Errorf("adding custom format '%s': %w", item.Name, err)
+		}
+
+		s.ids[item.Name] = id
+	}
+
+	s.report.add(ActionCreate, "custom format", item.Name)
+
+	return nil
+}
+
+func (s *formatSync) suffix() string {
+	if s.opts.Suffix == "" {
+		return DefaultSuffix
+	}
+
+	return s.opts.Suffix
+}
+
+// profile copies the scores of a source quality profile into a target quality profile:
+// the scores of the synced formats, and the minimum, upgrade and cutoff format scores.
+func (s *formatSync) profile(ctx context.Context, src, dst *formatProfile) error {
+	before, err := clone(&profileScores{
+		FormatItems:           *dst.items,
+		MinFormatScore:        *dst.minScore,
+		MinUpgradeFormatScore: *dst.minUpgradeScore,
+		CutoffFormatScore:     *dst.cutoffScore,
+	})
+	if err != nil {
+		return err
+	}
+
+	sortFormatItems(before.FormatItems)
+
+	after, err := clone(before)
+	if err != nil {
+		return err
+	}
+
+	for _, srcItem := range *src.items {
+		name, ok := s.names[srcItem.Name]
+		if !ok {
+			continue // This format was not synced.
+		}
+
+		idx := slices.IndexFunc(after.FormatItems, func(i *starr.FormatItem) bool { return i.Format == s.ids[name] })
+		if idx == -1 {
+			after.FormatItems = append(after.FormatItems, &starr.FormatItem{Format: s.ids[name], Name: name})
+			idx = len(after.FormatItems) - 1
+		}
+
+		after.FormatItems[idx].Score = srcItem.Score
+	}
+
+	sortFormatItems(after.FormatItems)
+	after.MinFormatScore = *src.minScore
+	after.MinUpgradeFormatScore = *src.minUpgradeScore
+	after.CutoffFormatScore = *src.cutoffScore
+
+	if equal(after, before) {
+		return nil
+	}
+
+	*dst.items = after.FormatItems
+	*dst.minScore, *dst.minUpgradeScore, *dst.cutoffScore =
+		after.MinFormatScore, after.MinUpgradeFormatScore, after.CutoffFormatScore
+
+	if !s.opts.DryRun {
+		if err := dst.save(ctx); err != nil {
+			return fmt.Errorf("updating quality profile '%s': %w", dst.name, err)
+		}
+	}
+
+	s.report.add(ActionUpdate, "quality profile", dst.name, diff(before, after)...)
+
+	return nil
+}
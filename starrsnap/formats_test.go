@@ -0,0 +1,86 @@
+package starrsnap_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrsnap"
+	"golift.io/starr/starrtest"
+)
+
+const x265Spec = `"specifications":[{"name":"x265","implementation":"ReleaseTitleSpecification",` +
+	`"negate":false,"required":false,"fields":[{"name":"value","value":"%s"}]}]`
+
+func sonarrServer(t *testing.T) (*sonarr.Sonarr, *starrtest.MockServer) {
+	t.Helper()
+
+	fake := starrtest.NewMockServer(t, map[string][]string{
+		"GET /api/v3/customFormat": {`[{"id":10,"name":"x265","includeCustomFormatWhenRenaming":false,` +
+			fmt.Sprintf(x265Spec, "(x|h)265") + `}]`},
+		"GET /api/v3/qualityProfile": {`[{"id":1,"name":"HD","minFormatScore":10,"cutoffFormatScore":100,` +
+			`"formatItems":[{"format":10,"name":"x265","score":5}]},` +
+			`{"id":2,"name":"SD","formatItems":[]}]`},
+		"PUT /api/v3/customFormat/10":  {`{"id":10,"name":"x265"}`},
+		"PUT /api/v3/qualityProfile/1": {`{"id":1,"name":"HD"}`},
+	})
+
+	return sonarr.New(starr.New("apikey", fake.URL, 0)), fake
+}
+
+func TestSyncFormats(t *testing.T) {
+	t.Parallel()
+
+	radarrApp, _ := radarrServer(t)
+	sonarrApp, fake := sonarrServer(t)
+	source, target := starrsnap.RadarrFormats(radarrApp), starrsnap.SonarrFormats(sonarrApp)
+
+	// The source has x265 with a different spec, and a score of zero in the HD profile.
+	reports, err := starrsnap.SyncFormats(t.Context(), nil, source, target)
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, "update custom format 'x265'\n"+
+		`    specifications[x265].fields[value].value: "(x|h)265" -> "x265"`+"\n"+
+		"update quality profile 'HD'\n"+
+		"    cutoffFormatScore: 100 -> 0\n"+
+		"    formatItems[x265].score: 5 -> 0\n"+
+		"    minFormatScore: 10 -> 0\n", reports[0].String())
+	assert.Contains(t, fake.Last("PUT /api/v3/qualityProfile/1").Body, `"minFormatScore":0`)
+	assert.Equal(t, []string{"PUT /api/v3/customFormat/10", "PUT /api/v3/qualityProfile/1"}, writes(fake))
+}
+
+func TestSyncFormatsPolicies(t *testing.T) {
+	t.Parallel()
+
+	radarrApp, _ := radarrServer(t)
+	sonarrApp, fake := sonarrServer(t)
+	source, target := starrsnap.RadarrFormats(radarrApp), starrsnap.SonarrFormats(sonarrApp)
+	opts := &starrsnap.SyncOptions{Policy: starrsnap.PolicySkip, DryRun: true}
+
+	reports, err := starrsnap.SyncFormats(t.Context(), opts, source, target)
+	require.NoError(t, err)
+	assert.Equal(t, "skip custom format 'x265'\n"+
+		"update quality profile 'HD'\n"+
+		"    cutoffFormatScore: 100 -> 0\n"+
+		"    formatItems[x265].score: 5 -> 0\n"+
+		"    minFormatScore: 10 -> 0\n", reports[0].String(), "scores must sync to skipped formats")
+
+	opts.Policy = starrsnap.PolicyRename
+	reports, err = starrsnap.SyncFormats(t.Context(), opts, source, target)
+	require.NoError(t, err)
+	assert.Equal(t, "create custom format 'x265 (synced)'\n"+
+		"update quality profile 'HD'\n"+
+		"    cutoffFormatScore: 100 -> 0\n"+
+		"    formatItems[x265 (synced)].format: (none) -> -1\n"+
+		"    formatItems[x265 (synced)].name: (none) -> \"x265 (synced)\"\n"+
+		"    formatItems[x265 (synced)].score: (none) -> 0\n"+
+		"    minFormatScore: 10 -> 0\n", reports[0].String())
+	assert.Empty(t, writes(fake), "a dry run must not change anything")
+
+	opts.Policy = "merge"
+	_, err = starrsnap.SyncFormats(t.Context(), opts, source, target)
+	require.ErrorIs(t, err, starrsnap.ErrPolicy)
+}
@@ -0,0 +1,409 @@
+package starrsnap
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+)
+
+// Lidarr is a snapshot of a Lidarr instance's configuration.
+// Root folders are captured for reference, but not applied; the lidarr package cannot add them.
+type Lidarr struct {
+	Header
+	Tags               []*starr.Tag                  `json:"tags"`
+	CustomFormats      []*lidarr.CustomFormatInput   `json:"customFormats"`
+	QualityProfiles    []*lidarr.QualityProfile      `json:"qualityProfiles"`
+	DelayProfiles      []*lidarr.DelayProfile        `json:"delayProfiles"`
+	ReleaseProfiles    []*lidarr.ReleaseProfile      `json:"releaseProfiles"`
+	Naming             *lidarr.Naming                `json:"naming"`
+	MediaManagement    *lidarr.MediaManagement       `json:"mediaManagement"`
+	DownloadClients    []*lidarr.DownloadClientInput `json:"downloadClients"`
+	Indexers           []*lidarr.IndexerInput        `json:"indexers"`
+	Notifications      []*lidarr.NotificationInput   `json:"notifications"`
+	RootFolders        []*lidarr.RootFolder          `json:"rootFolders"`
+	RemotePathMappings []*starr.RemotePathMapping    `json:"remotePathMappings"`
+}
+
+func (*Lidarr) app() starr.App {
+	return starr.Lidarr
+}
+
+// TakeLidarr captures the configuration of a Lidarr instance.
+func TakeLidarr(ctx context.Context, app *lidarr.Lidarr) (*Lidarr, error) {
+	snap := &Lidarr{Header: Header{Version: Version, App: starr.Lidarr}}
+
+	var err error
+
+	if snap.Tags, err = app.GetTagsContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting tags: %w", err)
+	}
+
+	if err = snap.takeProfiles(ctx, app); err != nil {
+		return nil, err
+	}
+
+	if err = snap.takeProviders(ctx, app); err != nil {
+		return nil, err
+	}
+
+	if snap.Naming, err = app.GetNamingContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting naming: %w", err)
+	}
+
+	if snap.MediaManagement, err = app.GetMediaManagementContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting media management: %w", err)
+	}
+
+	if snap.RootFolders, err = app.GetRootFoldersContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting root folders: %w", err)
+	}
+
+	if snap.RemotePathMappings, err = app.GetRemotePathMappingsContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting remote path mappings: %w", err)
+	}
+
+	snap.normalize()
+
+	return snap, nil
+}
+
+func (s *Lidarr) takeProfiles(ctx context.Context, app *lidarr.Lidarr) error {
+	formats, err := app.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting custom formats: %w", err)
+	}
+
+	s.CustomFormats = inputs(formats, (*lidarr.CustomFormatOutput).ToInput)
+
+	if s.QualityProfiles, err = app.GetQualityProfilesContext(ctx); err != nil {
+		return fmt.Errorf("getting quality profiles: %w", err)
+	}
+
+	if s.DelayProfiles, err = app.GetDelayProfilesContext(ctx); err != nil {
+		return fmt.Errorf("getting delay profiles: %w", err)
+	}
+
+	if s.ReleaseProfiles, err = app.GetReleaseProfilesContext(ctx); err != nil {
+		return fmt.Errorf("getting release profiles: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Lidarr) takeProviders(ctx context.Context, app *lidarr.Lidarr) error {
+	clients, err := app.GetDownloadClientsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting download clients: %w", err)
+	}
+
+	s.DownloadClients = inputs(clients, (*lidarr.DownloadClientOutput).ToInput)
+
+	indexers, err := app.GetIndexersContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting indexers: %w", err)
+	}
+
+	s.Indexers = inputs(indexers, (*lidarr.IndexerOutput).ToInput)
+
+	notifications, err := app.GetNotificationsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting notifications: %w", err)
+	}
+
+	s.Notifications = inputs(notifications, (*lidarr.NotificationOutput).ToInput)
+
+	return nil
+}
+
+// normalize sorts everything and drops volatile values, so snapshots are deterministic.
+func (s *Lidarr) normalize() {
+	s.Tags = sortBy(s.Tags, func(t *starr.Tag) string { return t.Label })
+	s.CustomFormats = sortBy(s.CustomFormats, func(f *lidarr.CustomFormatInput) string { return f.Name })
+	s.QualityProfiles = sortBy(s.QualityProfiles, func(p *lidarr.QualityProfile) string { return p.Name })
+	s.DownloadClients = sortBy(s.DownloadClients, func(c *lidarr.DownloadClientInput) string { return c.Name })
+	s.Indexers = sortBy(s.Indexers, func(i *lidarr.IndexerInput) string { return i.Name })
+	s.Notifications = sortBy(s.Notifications, func(n *lidarr.NotificationInput) string { return n.Name })
+	s.RootFolders = sortBy(s.RootFolders, func(f *lidarr.RootFolder) string { return f.Path })
+	s.RemotePathMappings = sortBy(s.RemotePathMappings, mappingKey)
+	s.DelayProfiles = sortBy(s.DelayProfiles, func(p *lidarr.DelayProfile) int64 { return p.Order })
+	s.ReleaseProfiles = sortBy(s.ReleaseProfiles, func(p *lidarr.ReleaseProfile) int64 { return p.ID })
+
+	for _, profile := range s.QualityProfiles {
+		sortFormatItems(profile.FormatItems)
+	}
+
+	for _, profile := range s.DelayProfiles {
+		slices.Sort(profile.Tags)
+	}
+
+	for _, profile := range s.ReleaseProfiles {
+		slices.Sort(profile.Tags)
+	}
+
+	for _, client := range s.DownloadClients {
+		slices.Sort(client.Tags)
+	}
+
+	for _, indexer := range s.Indexers {
+		slices.Sort(indexer.Tags)
+	}
+
+	for _, notification := range s.Notifications {
+		slices.Sort(notification.Tags)
+	}
+
+	for _, folder := range s.RootFolders {
+		folder.FreeSpace, folder.TotalSpace, folder.UnmappedFolders = 0, 0, nil
+	}
+}
+
+// Plan returns the changes Apply would make to a Lidarr instance, without making them.
+func (s *Lidarr) Plan(ctx context.Context, app *lidarr.Lidarr, opts *ApplyOptions) (*Report, error) {
+	return s.Apply(ctx, app, dryRun(opts))
+}
+
+// Apply makes a Lidarr instance match the snapshot, and returns the changes it made.
+// With the DryRun option, nothing is changed, and the report lists the planned changes.
+// The report is returned with an error too, and lists the changes made before the error.
+func (s *Lidarr) Apply(ctx context.Context, app *lidarr.Lidarr, opts *ApplyOptions) (*Report, error) {
+	live, err := TakeLidarr(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &lidarrRun{want: s, live: live, app: app, opts: opts, report: &Report{}, formatIDs: map[string]int64{}}
+	if run.opts == nil {
+		run.opts = &ApplyOptions{}
+	}
+
+	steps := []func(context.Context) error{
+		run.tags, run.customFormats, run.qualityProfiles, run.delayProfiles,
+		run.downloadClients, run.indexers, run.releaseProfiles, run.notifications, run.remotePathMappings, run.settings,
+	}
+
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return run.report, err
+		}
+	}
+
+	return run.report, nil
+}
+
+// lidarrRun holds the state of one Lidarr snapshot being applied.
+type lidarrRun struct {
+	want, live *Lidarr
+	app        *lidarr.Lidarr
+	opts       *ApplyOptions
+	report     *Report
+	tagIDs     idMap
+	formatIDs  map[string]int64
+	labels     map[int]string
+	indexerIDs idMap
+}
+
+func (r *lidarrRun) tags(ctx context.Context) (err error) {
+	r.tagIDs, r.labels, err = applyTags(ctx, r.want.Tags, r.live.Tags, r.app.AddTagContext, r.opts, r.report)
+	return err
+}
+
+func (r *lidarrRun) customFormats(ctx context.Context) error {
+	_, err := (&list[*lidarr.CustomFormatInput]{
+		kind: "custom format",
+		want: r.want.CustomFormats,
+		live: r.live.CustomFormats,
+		keys: r.formatIDs,
+		key:  func(f *lidarr.CustomFormatInput) string { return f.Name },
+		id:   func(f *lidarr.CustomFormatInput) *int64 { return &f.ID },
+		add: func(ctx context.Context, f *lidarr.CustomFormatInput) (int64, error) {
+			return created(func(o *lidarr.CustomFormatOutput) int64 { return o.ID })(r.app.AddCustomFormatContext(ctx, f))
+		},
+		update: func(ctx context.Context, f *lidarr.CustomFormatInput) error {
+			_, err := r.app.UpdateCustomFormatContext(ctx, f)
+			return err
+		},
+		remove: r.app.DeleteCustomFormatContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *lidarrRun) qualityProfiles(ctx context.Context) error {
+	_, err := (&list[*lidarr.QualityProfile]{
+		kind: "quality profile",
+		want: r.want.QualityProfiles,
+		live: r.live.QualityProfiles,
+		key:  func(p *lidarr.QualityProfile) string { return p.Name },
+		id:   func(p *lidarr.QualityProfile) *int64 { return &p.ID },
+		resolve: func(p *lidarr.QualityProfile) (err error) {
+			p.FormatItems, err = formatItems(p.FormatItems, r.formatIDs)
+			return err
+		},
+		add: func(ctx context.Context, p *lidarr.QualityProfile) (int64, error) {
+			return r.app.AddQualityProfileContext(ctx, p)
+		},
+		update: func(ctx context.Context, p *lidarr.QualityProfile) error {
+			_, err := r.app.UpdateQualityProfileContext(ctx, p)
+			return err
+		},
+		remove: r.app.DeleteQualityProfileContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *lidarrRun) delayProfiles(ctx context.Context) error {
+	_, err := (&list[*lidarr.DelayProfile]{
+		kind:  "delay profile",
+		want:  r.want.DelayProfiles,
+		live:  r.live.DelayProfiles,
+		key:   func(p *lidarr.DelayProfile) string { return tagKey(p.Tags, r.labels) },
+		id:    func(p *lidarr.DelayProfile) *int64 { return &p.ID },
+		remap: func(p *lidarr.DelayProfile) { p.Tags = r.tagIDs.tags(p.Tags) },
+		add: func(ctx context.Context, p *lidarr.DelayProfile) (int64, error) {
+			return created(func(o *lidarr.DelayProfile) int64 { return o.ID })(r.app.AddDelayProfileContext(ctx, p))
+		},
+		update: func(ctx context.Context, p *lidarr.DelayProfile) error {
+			_, err := r.app.UpdateDelayProfileContext(ctx, p)
+			return err
+		},
+		remove: r.app.DeleteDelayProfileContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *lidarrRun) downloadClients(ctx context.Context) error {
+	_, err := (&list[*lidarr.DownloadClientInput]{
+		kind:   "download client",
+		want:   r.want.DownloadClients,
+		live:   r.live.DownloadClients,
+		key:    func(c *lidarr.DownloadClientInput) string { return c.Name },
+		id:     func(c *lidarr.DownloadClientInput) *int64 { return &c.ID },
+		fields: func(c *lidarr.DownloadClientInput) (string, []*starr.FieldInput) { return c.Name, c.Fields },
+		remap:  func(c *lidarr.DownloadClientInput) { c.Tags = r.tagIDs.tags(c.Tags) },
+		add: func(ctx context.Context, c *lidarr.DownloadClientInput) (int64, error) {
+			return created(func(o *lidarr.DownloadClientOutput) int64 { return o.ID })(r.app.AddDownloadClientContext(ctx, c))
+		},
+		update: func(ctx context.Context, c *lidarr.DownloadClientInput) error {
+			_, err := r.app.UpdateDownloadClientContext(ctx, c, r.opts.ForceSave)
+			return err
+		},
+		remove: r.app.DeleteDownloadClientContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *lidarrRun) indexers(ctx context.Context) (err error) {
+	r.indexerIDs, err = (&list[*lidarr.IndexerInput]{
+		kind:   "indexer",
+		want:   r.want.Indexers,
+		live:   r.live.Indexers,
+		key:    func(i *lidarr.IndexerInput) string { return i.Name },
+		id:     func(i *lidarr.IndexerInput) *int64 { return &i.ID },
+		fields: func(i *lidarr.IndexerInput) (string, []*starr.FieldInput) { return i.Name, i.Fields },
+		remap:  func(i *lidarr.IndexerInput) { i.Tags = r.tagIDs.tags(i.Tags) },
+		add: func(ctx context.Context, i *lidarr.IndexerInput) (int64, error) {
+			return created(func(o *lidarr.IndexerOutput) int64 { return o.ID })(r.app.AddIndexerContext(ctx, i))
+		},
+		update: func(ctx context.Context, i *lidarr.IndexerInput) error {
+			_, err := r.app.UpdateIndexerContext(ctx, i, r.opts.ForceSave)
+			return err
+		},
+		remove: r.app.DeleteIndexerContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *lidarrRun) releaseProfiles(ctx context.Context) error {
+	_, err := (&list[*lidarr.ReleaseProfile]{
+		kind: "release profile",
+		want: r.want.ReleaseProfiles,
+		live: r.live.ReleaseProfiles,
+		key: func(p *lidarr.ReleaseProfile) string {
+			return termsKey(p.Required, p.Ignored, p.Tags, r.labels)
+		},
+		id:    func(p *lidarr.ReleaseProfile) *int64 { return &p.ID },
+		remap: func(p *lidarr.ReleaseProfile) { p.Tags = r.tagIDs.tags(p.Tags) },
+		resolve: func(p *lidarr.ReleaseProfile) (err error) {
+			p.IndexerID, err = r.indexerIDs.get("indexer", p.IndexerID)
+			return err
+		},
+		add: func(ctx context.Context, p *lidarr.ReleaseProfile) (int64, error) {
+			return created(func(o *lidarr.ReleaseProfile) int64 { return o.ID })(r.app.AddReleaseProfileContext(ctx, p))
+		},
+		update: func(ctx context.Context, p *lidarr.ReleaseProfile) error {
+			_, err := r.app.UpdateReleaseProfileContext(ctx, p)
+			return err
+		},
+		remove: r.app.DeleteReleaseProfileContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *lidarrRun) notifications(ctx context.Context) error {
+	_, err := (&list[*lidarr.NotificationInput]{
+		kind:   "notification",
+		want:   r.want.Notifications,
+		live:   r.live.Notifications,
+		key:    func(n *lidarr.NotificationInput) string { return n.Name },
+		id:     func(n *lidarr.NotificationInput) *int64 { return &n.ID },
+		fields: func(n *lidarr.NotificationInput) (string, []*starr.FieldInput) { return n.Name, n.Fields },
+		remap:  func(n *lidarr.NotificationInput) { n.Tags = r.tagIDs.tags(n.Tags) },
+		add: func(ctx context.Context, n *lidarr.NotificationInput) (int64, error) {
+			return created(func(o *lidarr.NotificationOutput) int64 { return o.ID })(r.app.AddNotificationContext(ctx, n))
+		},
+		update: func(ctx context.Context, n *lidarr.NotificationInput) error {
+			_, err := r.app.UpdateNotificationContext(ctx, n)
+			return err
+		},
+		remove: r.app.DeleteNotificationContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *lidarrRun) remotePathMappings(ctx context.Context) error {
+	_, err := (&list[*starr.RemotePathMapping]{
+		kind: "remote path mapping",
+		want: r.want.RemotePathMappings,
+		live: r.live.RemotePathMappings,
+		key:  mappingKey,
+		id:   func(m *starr.RemotePathMapping) *int64 { return &m.ID },
+		add: func(ctx context.Context, m *starr.RemotePathMapping) (int64, error) {
+			return created(mappingID)(r.app.AddRemotePathMappingContext(ctx, m))
+		},
+		update: func(ctx context.Context, m *starr.RemotePathMapping) error {
+			_, err := r.app.UpdateRemotePathMappingContext(ctx, m)
+			return err
+		},
+		remove: r.app.DeleteRemotePathMappingContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *lidarrRun) settings(ctx context.Context) error {
+	err := applyOne(ctx, "naming", r.want.Naming, r.live.Naming,
+		func(n *lidarr.Naming) *int64 { return &n.ID },
+		func(ctx context.Context, n *lidarr.Naming) error {
+			_, err := r.app.UpdateNamingContext(ctx, n)
+			return err
+		}, r.opts, r.report)
+	if err != nil {
+		return err
+	}
+
+	return applyOne(ctx, "media management", r.want.MediaManagement, r.live.MediaManagement,
+		func(m *lidarr.MediaManagement) *int64 { return &m.ID },
+		func(ctx context.Context, m *lidarr.MediaManagement) error {
+			_, err := r.app.UpdateMediaManagementContext(ctx, m)
+			return err
+		}, r.opts, r.report)
+}
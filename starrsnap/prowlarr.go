@@ -0,0 +1,262 @@
+package starrsnap
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"golift.io/starr"
+	"golift.io/starr/prowlarr"
+)
+
+// Prowlarr is a snapshot of a Prowlarr instance's configuration.
+type Prowlarr struct {
+	Header
+	Tags            []*starr.Tag                    `json:"tags"`
+	AppProfiles     []*prowlarr.AppProfile          `json:"appProfiles"`
+	Applications    []*prowlarr.ApplicationInput    `json:"applications"`
+	DownloadClients []*prowlarr.DownloadClientInput `json:"downloadClients"`
+	Indexers        []*prowlarr.IndexerInput        `json:"indexers"`
+	Notifications   []*prowlarr.NotificationInput   `json:"notifications"`
+}
+
+func (*Prowlarr) app() starr.App {
+	return starr.Prowlarr
+}
+
+// TakeProwlarr captures the configuration of a Prowlarr instance.
+func TakeProwlarr(ctx context.Context, app *prowlarr.Prowlarr) (*Prowlarr, error) {
+	snap := &Prowlarr{Header: Header{Version: Version, App: starr.Prowlarr}}
+
+	var err error
+
+	if snap.Tags, err = app.GetTagsContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting tags: %w", err)
+	}
+
+	if snap.AppProfiles, err = app.GetAppProfilesContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting app profiles: %w", err)
+	}
+
+	applications, err := app.GetApplicationsContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting applications: %w", err)
+	}
+
+	snap.Applications = inputs(applications, (*prowlarr.ApplicationOutput).ToInput)
+
+	clients, err := app.GetDownloadClientsContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting download clients: %w", err)
+	}
+
+	snap.DownloadClients = inputs(clients, (*prowlarr.DownloadClientOutput).ToInput)
+
+	indexers, err := app.GetIndexersContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting indexers: %w", err)
+	}
+
+	snap.Indexers = inputs(indexers, (*prowlarr.IndexerOutput).ToInput)
+
+	notifications, err := app.GetNotificationsContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting notifications: %w", err)
+	}
+
+	snap.Notifications = inputs(notifications, (*prowlarr.NotificationOutput).ToInput)
+
+	snap.normalize()
+
+	return snap, nil
+}
+
+// normalize sorts everything, so snapshots are deterministic.
+func (s *Prowlarr) normalize() {
+	s.Tags = sortBy(s.Tags, func(t *starr.Tag) string { return t.Label })
+	s.AppProfiles = sortBy(s.AppProfiles, func(p *prowlarr.AppProfile) string { return p.Name })
+	s.Applications = sortBy(s.Applications, func(a *prowlarr.ApplicationInput) string { return a.Name })
+	s.DownloadClients = sortBy(s.DownloadClients, func(c *prowlarr.DownloadClientInput) string { return c.Name })
+	s.Indexers = sortBy(s.Indexers, func(i *prowlarr.IndexerInput) string { return i.Name })
+	s.Notifications = sortBy(s.Notifications, func(n *prowlarr.NotificationInput) string { return n.Name })
+
+	for _, application := range s.Applications {
+		slices.Sort(application.Tags)
+	}
+
+	for _, client := range s.DownloadClients {
+		slices.Sort(client.Tags)
+	}
+
+	for _, indexer := range s.Indexers {
+		slices.Sort(indexer.Tags)
+	}
+
+	for _, notification := range s.Notifications {
+		slices.Sort(notification.Tags)
+	}
+}
+
+// Plan returns the changes Apply would make to a Prowlarr instance, without making them.
+func (s *Prowlarr) Plan(ctx context.Context, app *prowlarr.Prowlarr, opts *ApplyOptions) (*Report, error) {
+	return s.Apply(ctx, app, dryRun(opts))
+}
+
+// Apply makes a Prowlarr instance match the snapshot, and returns the changes it made.
+// With the DryRun option, nothing is changed, and the report lists the planned changes.
+// The report is returned with an error too, and lists the changes made before the error.
+func (s *Prowlarr) Apply(ctx context.Context, app *prowlarr.Prowlarr, opts *ApplyOptions) (*Report, error) {
+	live, err := TakeProwlarr(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &prowlarrRun{want: s, live: live, app: app, opts: opts, report: &Report{}}
+	if run.opts == nil {
+		run.opts = &ApplyOptions{}
+	}
+
+	steps := []func(context.Context) error{
+		run.tags, run.appProfiles, run.applications, run.downloadClients, run.indexers, run.notifications,
+	}
+
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return run.report, err
+		}
+	}
+
+	return run.report, nil
+}
+
+// prowlarrRun holds the state of one Prowlarr snapshot being applied.
+type prowlarrRun struct {
+	want, live *Prowlarr
+	app        *prowlarr.Prowlarr
+	opts       *ApplyOptions
+	report     *Report
+	tagIDs     idMap
+	profileIDs idMap
+}
+
+func (r *prowlarrRun) tags(ctx context.Context) (err error) {
+	r.tagIDs, _, err = applyTags(ctx, r.want.Tags, r.live.Tags, r.app.AddTagContext, r.opts, r.report)
+	return err
+}
+
+func (r *prowlarrRun) appProfiles(ctx context.Context) (err error) {
+	r.profileIDs, err = (&list[*prowlarr.AppProfile]{
+		kind: "app profile",
+		want: r.want.AppProfiles,
+		live: r.live.AppProfiles,
+		key:  func(p *prowlarr.AppProfile) string { return p.Name },
+		id:   func(p *prowlarr.AppProfile) *int64 { return &p.ID },
+		add: func(ctx context.Context, p *prowlarr.AppProfile) (int64, error) {
+			return created(func(o *prowlarr.AppProfile) int64 { return o.ID })(r.app.AddAppProfileContext(ctx, p))
+		},
+		update: func(ctx context.Context, p *prowlarr.AppProfile) error {
+			_, err := r.app.UpdateAppProfileContext(ctx, p)
+			return err
+		},
+		remove: r.app.DeleteAppProfileContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *prowlarrRun) downloadClients(ctx context.Context) error {
+	_, err := (&list[*prowlarr.DownloadClientInput]{
+		kind:   "download client",
+		want:   r.want.DownloadClients,
+		live:   r.live.DownloadClients,
+		key:    func(c *prowlarr.DownloadClientInput) string { return c.Name },
+		id:     func(c *prowlarr.DownloadClientInput) *int64 { return &c.ID },
+		fields: func(c *prowlarr.DownloadClientInput) (string, []*starr.FieldInput) { return c.Name, c.Fields },
+		remap:  func(c *prowlarr.DownloadClientInput) { c.Tags = r.tagIDs.tags(c.Tags) },
+		add: func(ctx context.Context, c *prowlarr.DownloadClientInput) (int64, error) {
+			return created(func(o *prowlarr.DownloadClientOutput) int64 { return o.ID })(r.app.AddDownloadClientContext(ctx, c))
+		},
+		update: func(ctx context.Context, c *prowlarr.DownloadClientInput) error {
+			_, err := r.app.UpdateDownloadClientContext(ctx, c, r.opts.ForceSave)
+			return err
+		},
+		remove: r.app.DeleteDownloadClientContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *prowlarrRun) applications(ctx context.Context) error {
+	_, err := (&list[*prowlarr.ApplicationInput]{
+		kind:   "application",
+		want:   r.want.Applications,
+		live:   r.live.Applications,
+		key:    func(a *prowlarr.ApplicationInput) string { return a.Name },
+		id:     func(a *prowlarr.ApplicationInput) *int64 { return &a.ID },
+		fields: func(a *prowlarr.ApplicationInput) (string, []*starr.FieldInput) { return a.Name, a.Fields },
+		remap:  func(a *prowlarr.ApplicationInput) { a.Tags = r.tagIDs.tags(a.Tags) },
+		resolve: func(a *prowlarr.ApplicationInput) (err error) {
+			a.AppProfileID, err = r.profileIDs.get("app profile", a.AppProfileID)
+			return err
+		},
+		add: func(ctx context.Context, a *prowlarr.ApplicationInput) (int64, error) {
+			return created(func(o *prowlarr.ApplicationOutput) int64 { return o.ID })(
+				r.app.AddApplicationContext(ctx, a, r.opts.ForceSave))
+		},
+		update: func(ctx context.Context, a *prowlarr.ApplicationInput) error {
+			_, err := r.app.UpdateApplicationContext(ctx, a, r.opts.ForceSave)
+			return err
+		},
+		remove: r.app.DeleteApplicationContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *prowlarrRun) indexers(ctx context.Context) error {
+	_, err := (&list[*prowlarr.IndexerInput]{
+		kind:   "indexer",
+		want:   r.want.Indexers,
+		live:   r.live.Indexers,
+		key:    func(i *prowlarr.IndexerInput) string { return i.Name },
+		id:     func(i *prowlarr.IndexerInput) *int64 { return &i.ID },
+		fields: func(i *prowlarr.IndexerInput) (string, []*starr.FieldInput) { return i.Name, i.Fields },
+		remap:  func(i *prowlarr.IndexerInput) { i.Tags = r.tagIDs.tags(i.Tags) },
+		resolve: func(i *prowlarr.IndexerInput) (err error) {
+			i.AppProfileID, err = r.profileIDs.get("app profile", i.AppProfileID)
+			return err
+		},
+		add: func(ctx context.Context, i *prowlarr.IndexerInput) (int64, error) {
+			return created(func(o *prowlarr.IndexerOutput) int64 { return o.ID })(r.app.AddIndexerContext(ctx, i))
+		},
+		update: func(ctx context.Context, i *prowlarr.IndexerInput) error {
+			_, err := r.app.UpdateIndexerContext(ctx, i, r.opts.ForceSave)
+			return err
+		},
+		remove: r.app.DeleteIndexerContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *prowlarrRun) notifications(ctx context.Context) error {
+	_, err := (&list[*prowlarr.NotificationInput]{
+		kind:   "notification",
+		want:   r.want.Notifications,
+		live:   r.live.Notifications,
+		key:    func(n *prowlarr.NotificationInput) string { return n.Name },
+		id:     func(n *prowlarr.NotificationInput) *int64 { return &n.ID },
+		fields: func(n *prowlarr.NotificationInput) (string, []*starr.FieldInput) { return n.Name, n.Fields },
+		remap:  func(n *prowlarr.NotificationInput) { n.Tags = r.tagIDs.tags(n.Tags) },
+		add: func(ctx context.Context, n *prowlarr.NotificationInput) (int64, error) {
+			return created(func(o *prowlarr.NotificationOutput) int64 { return o.ID })(r.app.AddNotificationContext(ctx, n))
+		},
+		update: func(ctx context.Context, n *prowlarr.NotificationInput) error {
+			_, err := r.app.UpdateNotificationContext(ctx, n)
+			return err
+		},
+		remove: r.app.DeleteNotificationContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
@@ -0,0 +1,428 @@
+package starrsnap
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+)
+
+// Radarr is a snapshot of a Radarr instance's configuration.
+type Radarr struct {
+	Header
+	Tags               []*starr.Tag                  `json:"tags"`
+	CustomFormats      []*radarr.CustomFormatInput   `json:"customFormats"`
+	QualityProfiles    []*radarr.QualityProfile      `json:"qualityProfiles"`
+	DelayProfiles      []*radarr.DelayProfile        `json:"delayProfiles"`
+	ReleaseProfiles    []*radarr.ReleaseProfile      `json:"releaseProfiles"`
+	Naming             *radarr.Naming                `json:"naming"`
+	MediaManagement    *radarr.MediaManagement       `json:"mediaManagement"`
+	DownloadClients    []*radarr.DownloadClientInput `json:"downloadClients"`
+	Indexers           []*radarr.IndexerInput        `json:"indexers"`
+	Notifications      []*radarr.NotificationInput   `json:"notifications"`
+	RootFolders        []*radarr.RootFolder          `json:"rootFolders"`
+	RemotePathMappings []*starr.RemotePathMapping    `json:"remotePathMappings"`
+}
+
+func (*Radarr) app() starr.App {
+	return starr.Radarr
+}
+
+// TakeRadarr captures the configuration of a Radarr instance.
+func TakeRadarr(ctx context.Context, app *radarr.Radarr) (*Radarr, error) {
+	snap := &Radarr{Header: Header{Version: Version, App: starr.Radarr}}
+
+	var err error
+
+	if snap.Tags, err = app.GetTagsContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting tags: %w", err)
+	}
+
+	if err = snap.takeProfiles(ctx, app); err != nil {
+		return nil, err
+	}
+
+	if err = snap.takeProviders(ctx, app); err != nil {
+		return nil, err
+	}
+
+	if snap.Naming, err = app.GetNamingContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting naming: %w", err)
+	}
+
+	if snap.MediaManagement, err = app.GetMediaManagementContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting media management: %w", err)
+	}
+
+	if snap.RootFolders, err = app.GetRootFoldersContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting root folders: %w", err)
+	}
+
+	if snap.RemotePathMappings, err = app.GetRemotePathMappingsContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting remote path mappings: %w", err)
+	}
+
+	snap.normalize()
+
+	return snap, nil
+}
+
+func (s *Radarr) takeProfiles(ctx context.Context, app *radarr.Radarr) error {
+	formats, err := app.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting custom formats: %w", err)
+	}
+
+	s.CustomFormats = inputs(formats, (*radarr.CustomFormatOutput).ToInput)
+
+	if s.QualityProfiles, err = app.GetQualityProfilesContext(ctx); err != nil {
+		return fmt.Errorf("getting quality profiles: %w", err)
+	}
+
+	if s.DelayProfiles, err = app.GetDelayProfilesContext(ctx); err != nil {
+		return fmt.Errorf("getting delay profiles: %w", err)
+	}
+
+	if s.ReleaseProfiles, err = app.GetReleaseProfilesContext(ctx); err != nil {
+		return fmt.Errorf("getting release profiles: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Radarr) takeProviders(ctx context.Context, app *radarr.Radarr) error {
+	clients, err := app.GetDownloadClientsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting download clients: %w", err)
+	}
+
+	s.DownloadClients = inputs(clients, (*radarr.DownloadClientOutput).ToInput)
+
+	indexers, err := app.GetIndexersContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting indexers: %w", err)
+	}
+
+	s.Indexers = inputs(indexers, (*radarr.IndexerOutput).ToInput)
+
+	notifications, err := app.GetNotificationsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting notifications: %w", err)
+	}
+
+	s.Notifications = inputs(notifications, (*radarr.NotificationOutput).ToInput)
+
+	return nil
+}
+
+// normalize sorts everything and drops volatile values, so snapshots are deterministic.
+func (s *Radarr) normalize() {
+	s.Tags = sortBy(s.Tags, func(t *starr.Tag) string { return t.Label })
+	s.CustomFormats = sortBy(s.CustomFormats, func(f *radarr.CustomFormatInput) string { return f.Name })
+	s.QualityProfiles = sortBy(s.QualityProfiles, func(p *radarr.QualityProfile) string { return p.Name })
+	s.ReleaseProfiles = sortBy(s.ReleaseProfiles, func(p *radarr.ReleaseProfile) string { return p.Name })
+	s.DownloadClients = sortBy(s.DownloadClients, func(c *radarr.DownloadClientInput) string { return c.Name })
+	s.Indexers = sortBy(s.Indexers, func(i *radarr.IndexerInput) string { return i.Name })
+	s.Notifications = sortBy(s.Notifications, func(n *radarr.NotificationInput) string { return n.Name })
+	s.RootFolders = sortBy(s.RootFolders, func(f *radarr.RootFolder) string { return f.Path })
+	s.RemotePathMappings = sortBy(s.RemotePathMappings, mappingKey)
+	s.DelayProfiles = sortBy(s.DelayProfiles, func(p *radarr.DelayProfile) int64 { return p.Order })
+
+	for _, profile := range s.QualityProfiles {
+		sortFormatItems(profile.FormatItems)
+	}
+
+	for _, profile := range s.DelayProfiles {
+		slices.Sort(profile.Tags)
+	}
+
+	for _, profile := range s.ReleaseProfiles {
+		slices.Sort(profile.Tags)
+	}
+
+	for _, client := range s.DownloadClients {
+		slices.Sort(client.Tags)
+	}
+
+	for _, indexer := range s.Indexers {
+		slices.Sort(indexer.Tags)
+	}
+
+	for _, notification := range s.Notifications {
+		slices.Sort(notification.Tags)
+	}
+
+	for _, folder := range s.RootFolders {
+		folder.Accessible, folder.FreeSpace, folder.UnmappedFolders = false, 0, nil
+	}
+}
+
+// Plan returns the changes Apply would make to a Radarr instance, without making them.
+func (s *Radarr) Plan(ctx context.Context, app *radarr.Radarr, opts *ApplyOptions) (*Report, error) {
+	return s.Apply(ctx, app, dryRun(opts))
+}
+
+// Apply makes a Radarr instance match the snapshot, and returns the changes it made.
+// With the DryRun option, nothing is changed, and the report lists the planned changes.
+// The report is returned with an error too, and lists the changes made before the error.
+func (s *Radarr) Apply(ctx context.Context, app *radarr.Radarr, opts *ApplyOptions) (*Report, error) {
+	live, err := TakeRadarr(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &radarrRun{want: s, live: live, app: app, opts: opts, report: &Report{}, formatIDs: map[string]int64{}}
+	if run.opts == nil {
+		run.opts = &ApplyOptions{}
+	}
+
+	steps := []func(context.Context) error{
+		run.tags, run.customFormats, run.qualityProfiles, run.delayProfiles,
+		run.downloadClients, run.indexers, run.releaseProfiles, run.notifications,
+		run.rootFolders, run.remotePathMappings, run.settings,
+	}
+
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return run.report, err
+		}
+	}
+
+	return run.report, nil
+}
+
+// radarrRun holds the state of one Radarr snapshot being applied.
+type radarrRun struct {
+	want, live *Radarr
+	app        *radarr.Radarr
+	opts       *ApplyOptions
+	report     *Report
+	tagIDs     idMap
+	formatIDs  map[string]int64
+	labels     map[int]string
+	clientIDs  idMap
+	indexerIDs idMap
+}
+
+func (r *radarrRun) tags(ctx context.Context) (err error) {
+	r.tagIDs, r.labels, err = applyTags(ctx, r.want.Tags, r.live.Tags, r.app.AddTagContext, r.opts, r.report)
+	return err
+}
+
+func (r *radarrRun) customFormats(ctx context.Context) error {
+	_, err := (&list[*radarr.CustomFormatInput]{
+		kind: "custom format",
+		want: r.want.CustomFormats,
+		live: r.live.CustomFormats,
+		keys: r.formatIDs,
+		key:  func(f *radarr.CustomFormatInput) string { return f.Name },
+		id:   func(f *radarr.CustomFormatInput) *int64 { return &f.ID },
+		add: func(ctx context.Context, f *radarr.CustomFormatInput) (int64, error) {
+			return created(func(o *radarr.CustomFormatOutput) int64 { return o.ID })(r.app.AddCustomFormatContext(ctx, f))
+		},
+		update: func(ctx context.Context, f *radarr.CustomFormatInput) error {
+			_, err := r.app.UpdateCustomFormatContext(ctx, f)
+			return err
+		},
+		remove: r.app.DeleteCustomFormatContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *radarrRun) qualityProfiles(ctx context.Context) error {
+	_, err := (&list[*radarr.QualityProfile]{
+		kind: "quality profile",
+		want: r.want.QualityProfiles,
+		live: r.live.QualityProfiles,
+		key:  func(p *radarr.QualityProfile) string { return p.Name },
+		id:   func(p *radarr.QualityProfile) *int64 { return &p.ID },
+		resolve: func(p *radarr.QualityProfile) (err error) {
+			p.FormatItems, err = formatItems(p.FormatItems, r.formatIDs)
+			return err
+		},
+		add: func(ctx context.Context, p *radarr.QualityProfile) (int64, error) {
+			return created(func(o *radarr.QualityProfile) int64 { return o.ID })(r.app.AddQualityProfileContext(ctx, p))
+		},
+		update: func(ctx context.Context, p *radarr.QualityProfile) error {
+			_, err := r.app.UpdateQualityProfileContext(ctx, p)
+			return err
+		},
+		remove: r.app.DeleteQualityProfileContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *radarrRun) delayProfiles(ctx context.Context) error {
+	_, err := (&list[*radarr.DelayProfile]{
+		kind:  "delay profile",
+		want:  r.want.DelayProfiles,
+		live:  r.live.DelayProfiles,
+		key:   func(p *radarr.DelayProfile) string { return tagKey(p.Tags, r.labels) },
+		id:    func(p *radarr.DelayProfile) *int64 { return &p.ID },
+		remap: func(p *radarr.DelayProfile) { p.Tags = r.tagIDs.tags(p.Tags) },
+		add: func(ctx context.Context, p *radarr.DelayProfile) (int64, error) {
+			return created(func(o *radarr.DelayProfile) int64 { return o.ID })(r.app.AddDelayProfileContext(ctx, p))
+		},
+		update: func(ctx context.Context, p *radarr.DelayProfile) error {
+			_, err := r.app.UpdateDelayProfileContext(ctx, p)
+			return err
+		},
+		remove: r.app.DeleteDelayProfileContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *radarrRun) downloadClients(ctx context.Context) (err error) {
+	r.clientIDs, err = (&list[*radarr.DownloadClientInput]{
+		kind:   "download client",
+		want:   r.want.DownloadClients,
+		live:   r.live.DownloadClients,
+		key:    func(c *radarr.DownloadClientInput) string { return c.Name },
+		id:     func(c *radarr.DownloadClientInput) *int64 { return &c.ID },
+		fields: func(c *radarr.DownloadClientInput) (string, []*starr.FieldInput) { return c.Name, c.Fields },
+		remap:  func(c *radarr.DownloadClientInput) { c.Tags = r.tagIDs.tags(c.Tags) },
+		add: func(ctx context.Context, c *radarr.DownloadClientInput) (int64, error) {
+			return created(func(o *radarr.DownloadClientOutput) int64 { return o.ID })(r.app.AddDownloadClientContext(ctx, c))
+		},
+		update: func(ctx context.Context, c *radarr.DownloadClientInput) error {
+			_, err := r.app.UpdateDownloadClientContext(ctx, c, r.opts.ForceSave)
+			return err
+		},
+		remove: r.app.DeleteDownloadClientContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *radarrRun) indexers(ctx context.Context) (err error) {
+	r.indexerIDs, err = (&list[*radarr.IndexerInput]{
+		kind:   "indexer",
+		want:   r.want.Indexers,
+		live:   r.live.Indexers,
+		key:    func(i *radarr.IndexerInput) string { return i.Name },
+		id:     func(i *radarr.IndexerInput) *int64 { return &i.ID },
+		fields: func(i *radarr.IndexerInput) (string, []*starr.FieldInput) { return i.Name, i.Fields },
+		remap:  func(i *radarr.IndexerInput) { i.Tags = r.tagIDs.tags(i.Tags) },
+		resolve: func(i *radarr.IndexerInput) (err error) {
+			i.DownloadClientID, err = r.clientIDs.get("download client", i.DownloadClientID)
+			return err
+		},
+		add: func(ctx context.Context, i *radarr.IndexerInput) (int64, error) {
+			return created(func(o *radarr.IndexerOutput) int64 { return o.ID })(r.app.AddIndexerContext(ctx, i))
+		},
+		update: func(ctx context.Context, i *radarr.IndexerInput) error {
+			_, err := r.app.UpdateIndexerContext(ctx, i, r.opts.ForceSave)
+			return err
+		},
+		remove: r.app.DeleteIndexerContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *radarrRun) releaseProfiles(ctx context.Context) error {
+	_, err := (&list[*radarr.ReleaseProfile]{
+		kind:  "release profile",
+		want:  r.want.ReleaseProfiles,
+		live:  r.live.ReleaseProfiles,
+		key:   func(p *radarr.ReleaseProfile) string { return p.Name },
+		id:    func(p *radarr.ReleaseProfile) *int64 { return &p.ID },
+		remap: func(p *radarr.ReleaseProfile) { p.Tags = r.tagIDs.tags(p.Tags) },
+		resolve: func(p *radarr.ReleaseProfile) (err error) {
+			p.IndexerID, err = r.indexerIDs.get("indexer", p.IndexerID)
+			return err
+		},
+		add: func(ctx context.Context, p *radarr.ReleaseProfile) (int64, error) {
+			return created(func(o *radarr.ReleaseProfile) int64 { return o.ID })(r.app.AddReleaseProfileContext(ctx, p))
+		},
+		update: func(ctx context.Context, p *radarr.ReleaseProfile) error {
+			_, err := r.app.UpdateReleaseProfileContext(ctx, p)
+			return err
+		},
+		remove: r.app.DeleteReleaseProfileContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *radarrRun) notifications(ctx context.Context) error {
+	_, err := (&list[*radarr.NotificationInput]{
+		kind:   "notification",
+		want:   r.want.Notifications,
+		live:   r.live.Notifications,
+		key:    func(n *radarr.NotificationInput) string { return n.Name },
+		id:     func(n *radarr.NotificationInput) *int64 { return &n.ID },
+		fields: func(n *radarr.NotificationInput) (string, []*starr.FieldInput) { return n.Name, n.Fields },
+		remap:  func(n *radarr.NotificationInput) { n.Tags = r.tagIDs.tags(n.Tags) },
+		add: func(ctx context.Context, n *radarr.NotificationInput) (int64, error) {
+			return created(func(o *radarr.NotificationOutput) int64 { return o.ID })(r.app.AddNotificationContext(ctx, n))
+		},
+		update: func(ctx context.Context, n *radarr.NotificationInput) error {
+			_, err := r.app.UpdateNotificationContext(ctx, n)
+			return err
+		},
+		remove: r.app.DeleteNotificationContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *radarrRun) rootFolders(ctx context.Context) error {
+	_, err := (&list[*radarr.RootFolder]{
+		kind: "root folder",
+		want: r.want.RootFolders,
+		live: r.live.RootFolders,
+		key:  func(f *radarr.RootFolder) string { return f.Path },
+		id:   func(f *radarr.RootFolder) *int64 { return &f.ID },
+		add: func(ctx context.Context, f *radarr.RootFolder) (int64, error) {
+			return created(func(o *radarr.RootFolder) int64 { return o.ID })(r.app.AddRootFolderContext(ctx, f))
+		},
+		remove: r.app.DeleteRootFolderContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *radarrRun) remotePathMappings(ctx context.Context) error {
+	_, err := (&list[*starr.RemotePathMapping]{
+		kind: "remote path mapping",
+		want: r.want.RemotePathMappings,
+		live: r.live.RemotePathMappings,
+		key:  mappingKey,
+		id:   func(m *starr.RemotePathMapping) *int64 { return &m.ID },
+		add: func(ctx context.Context, m *starr.RemotePathMapping) (int64, error) {
+			return created(mappingID)(r.app.AddRemotePathMappingContext(ctx, m))
+		},
+		update: func(ctx context.Context, m *starr.RemotePathMapping) error {
+			_, err := r.app.UpdateRemotePathMappingContext(ctx, m)
+			return err
+		},
+		remove: r.app.DeleteRemotePathMappingContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *radarrRun) settings(ctx context.Context) error {
+	err := applyOne(ctx, "naming", r.want.Naming, r.live.Naming,
+		func(n *radarr.Naming) *int64 { return &n.ID },
+		func(ctx context.Context, n *radarr.Naming) error {
+			_, err := r.app.UpdateNamingContext(ctx, n)
+			return err
+		}, r.opts, r.report)
+	if err != nil {
+		return err
+	}
+
+	return applyOne(ctx, "media management", r.want.MediaManagement, r.live.MediaManagement,
+		func(m *radarr.MediaManagement) *int64 { return &m.ID },
+		func(ctx context.Context, m *radarr.MediaManagement) error {
+			_, err := r.app.UpdateMediaManagementContext(ctx, m)
+			return err
+		}, r.opts, r.report)
+}
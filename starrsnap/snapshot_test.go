@@ -0,0 +1,241 @@
+package starrsnap_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrsnap"
+	"golift.io/starr/starrtest"
+)
+
+// writes returns the requests that change something on a mock server.
+func writes(fake *starrtest.MockServer) []string {
+	return fake.Calls(http.MethodPost, http.MethodPut, http.MethodDelete)
+}
+
+func radarrServer(t *testing.T) (*radarr.Radarr, *starrtest.MockServer) {
+	t.Helper()
+
+	fake := starrtest.NewMockServer(t, map[string][]string{
+		"GET /api/v3/tag": {`[]`},
+		"GET /api/v3/customFormat": {`[{"id":3,"name":"x265","includeCustomFormatWhenRenaming":false,` +
+			`"specifications":[{"name":"x265","implementation":"ReleaseTitleSpecification",` +
+			`"implementationName":"Release Title","negate":false,"required":false,` +
+			`"fields":[{"name":"value","label":"Regular Expression","value":"x265","privacy":"normal"}]}]}]`},
+		"GET /api/v3/qualityProfile": {`[{"id":4,"name":"HD","upgradeAllowed":true,"cutoff":7,"items":[],` +
+			`"formatItems":[{"format":3,"name":"x265","score":0}]}]`},
+		"GET /api/v3/delayProfile":           {`[{"id":1,"enableUsenet":true,"order":2147483647,"tags":[]}]`},
+		"GET /api/v3/releaseProfile":         {`[]`},
+		"GET /api/v3/downloadClient":         {`[]`},
+		"GET /api/v3/indexer":                {`[]`},
+		"GET /api/v3/notification":           {`[]`},
+		"GET /api/v3/config/naming":          {`{"id":1,"renameMovies":true,"standardMovieFormat":"{Movie Title}"}`},
+		"GET /api/v3/config/mediaManagement": {`{"id":1,"recycleBin":"/trash"}`},
+		"GET /api/v3/rootFolder":             {`[{"id":2,"path":"/movies","accessible":true,"freeSpace":12345}]`},
+		"GET /api/v3/remotePathMapping":      {`[]`},
+		"POST /api/v3/tag":                   {`{"id":99,"label":"4k"}`},
+		"POST /api/v3/downloadClient":        {`{"id":50,"name":"qbit"}`},
+		"PUT /api/v3/qualityProfile/4":       {`{"id":4,"name":"HD"}`},
+		"DELETE /api/v3/customFormat/3":      {``},
+	})
+
+	return radarr.New(starr.New("apikey", fake.URL, 0)), fake
+}
+
+func TestTakeRadarr(t *testing.T) {
+	t.Parallel()
+
+	app, _ := radarrServer(t)
+
+	snap, err := starrsnap.TakeRadarr(t.Context(), app)
+	require.NoError(t, err)
+	assert.Equal(t, starrsnap.Version, snap.Version)
+	assert.Equal(t, starr.Radarr, snap.App)
+	require.Len(t, snap.CustomFormats, 1)
+	assert.Equal(t, "x265", snap.CustomFormats[0].Specifications[0].Fields[0].Value,
+		"output fields must be converted into input fields")
+	require.Len(t, snap.RootFolders, 1)
+	assert.Zero(t, snap.RootFolders[0].FreeSpace, "volatile values must not be in a snapshot")
+	assert.NotNil(t, snap.Indexers, "empty lists must be kept, so they can be applied")
+
+	var first, second bytes.Buffer
+
+	require.NoError(t, starrsnap.Encode(&first, starrsnap.FormatJSON, snap))
+
+	snap, err = starrsnap.TakeRadarr(t.Context(), app)
+	require.NoError(t, err)
+	require.NoError(t, starrsnap.Encode(&second, starrsnap.FormatJSON, snap))
+	assert.Equal(t, first.String(), second.String(), "snapshots must be deterministic")
+}
+
+func TestEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	snap := &starrsnap.Radarr{
+		Header: starrsnap.Header{Version: starrsnap.Version, App: starr.Radarr},
+		Tags:   []*starr.Tag{{ID: 1, Label: "123"}, {ID: 2, Label: "true"}},
+		QualityProfiles: []*radarr.QualityProfile{{
+			ID: 5, Name: "HD", Cutoff: 1234567890123,
+			FormatItems: []*starr.FormatItem{{Format: 3, Name: "x265", Score: -10}},
+		}},
+	}
+
+	for _, format := range []starrsnap.Format{starrsnap.FormatJSON, starrsnap.FormatYAML} {
+		var buf bytes.Buffer
+
+		require.NoError(t, starrsnap.Encode(&buf, format, snap), format)
+
+		if format == starrsnap.FormatYAML {
+			assert.Contains(t, buf.String(), "version: 1\n", "yaml must keep the field order")
+			assert.Contains(t, buf.String(), "cutoff: 1234567890123\n", "large numbers must not turn into floats")
+		}
+
+		decoded := &starrsnap.Radarr{}
+		require.NoError(t, starrsnap.Decode(&buf, format, decoded), format)
+		assert.Equal(t, snap, decoded, "the snapshot did not survive an encoding round trip: %s", format)
+	}
+
+	err := starrsnap.Decode(strings.NewReader(`{"version":1,"app":"Sonarr"}`), starrsnap.FormatJSON, &starrsnap.Radarr{})
+	require.ErrorIs(t, err, starrsnap.ErrWrongApp)
+	err = starrsnap.Decode(strings.NewReader("version: 9\napp: Radarr\n"), starrsnap.FormatYAML, &starrsnap.Radarr{})
+	require.ErrorIs(t, err, starrsnap.ErrVersion)
+	err = starrsnap.Encode(io.Discard, "toml", snap)
+	require.ErrorIs(t, err, starrsnap.ErrFormat)
+}
+
+func TestRadarrApply(t *testing.T) {
+	t.Parallel()
+
+	app, fake := radarrServer(t)
+	// IDs in this snapshot come from another instance.
+	snap := &starrsnap.Radarr{
+		Tags: []*starr.Tag{{ID: 5, Label: "4k"}},
+		CustomFormats: []*radarr.CustomFormatInput{{
+			ID: 7, Name: "x265",
+			Specifications: []*radarr.CustomFormatInputSpec{{
+				Name: "x265", Implementation: "ReleaseTitleSpecification",
+				Fields: []*starr.FieldInput{{Name: "value", Value: "x265"}},
+			}},
+		}},
+		QualityProfiles: []*radarr.QualityProfile{{
+			ID: 8, Name: "HD", UpgradeAllowed: true, Cutoff: 7, Qualities: []*starr.Quality{},
+			FormatItems: []*starr.FormatItem{{Format: 7, Name: "x265", Score: 100}},
+		}},
+		DownloadClients: []*radarr.DownloadClientInput{{ID: 9, Name: "qbit", Tags: []int{5}}},
+		RootFolders:     []*radarr.RootFolder{{ID: 3, Path: "/movies"}},
+	}
+
+	report, err := snap.Apply(t.Context(), app, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*starrsnap.Change{
+		{Action: starrsnap.ActionCreate, Kind: "tag", Name: "4k"},
+		{
+			Action: starrsnap.ActionUpdate, Kind: "quality profile", Name: "HD",
+			Diff: []string{"formatItems[x265].score: 0 -> 100"},
+		},
+		{Action: starrsnap.ActionCreate, Kind: "download client", Name: "qbit"},
+	}, report.Changes)
+	assert.Equal(t, []string{
+		"POST /api/v3/tag", "PUT /api/v3/qualityProfile/4", "POST /api/v3/downloadClient",
+	}, writes(fake))
+	assert.Contains(t, fake.Last("PUT /api/v3/qualityProfile/4").Body, `"formatItems":[{"format":3,"name":"x265","score":100}]`,
+		"custom format IDs must be remapped by name")
+	assert.Contains(t, fake.Last("POST /api/v3/downloadClient").Body, `"tags":[99]`, "tag IDs must be remapped by label")
+	assert.Equal(t, int64(8), snap.QualityProfiles[0].ID, "Apply must not change the snapshot")
+}
+
+func TestRadarrApplyPrune(t *testing.T) {
+	t.Parallel()
+
+	app, fake := radarrServer(t)
+	snap := &starrsnap.Radarr{CustomFormats: []*radarr.CustomFormatInput{}}
+
+	report, err := snap.Apply(t.Context(), app, &starrsnap.ApplyOptions{Prune: true})
+	require.NoError(t, err)
+	assert.Equal(t, "delete custom format 'x265'\n", report.String())
+	assert.Equal(t, []string{"DELETE /api/v3/customFormat/3"}, writes(fake),
+		"only lists present in the snapshot may be pruned")
+}
+
+func TestRadarrPlan(t *testing.T) {
+	t.Parallel()
+
+	app, fake := radarrServer(t)
+	snap := &starrsnap.Radarr{
+		Tags: []*starr.Tag{{ID: 5, Label: "4k"}},
+		CustomFormats: []*radarr.CustomFormatInput{
+			{ID: 8, Name: "HDR", Specifications: []*radarr.CustomFormatInputSpec{}},
+		},
+		DelayProfiles: []*radarr.DelayProfile{{ID: 1, EnableTorrent: true, Order: 2147483647, Tags: []int{}}},
+		Naming:        &radarr.Naming{RenameMovies: true, StandardMovieFormat: "{Movie Title} ({Release Year})"},
+		RootFolders:   []*radarr.RootFolder{},
+		DownloadClients: []*radarr.DownloadClientInput{
+			{ID: 9, Name: "qbit", Tags: []int{5}},
+		},
+	}
+
+	report, err := snap.Plan(t.Context(), app, &starrsnap.ApplyOptions{Prune: true})
+	require.NoError(t, err)
+	assert.Empty(t, writes(fake), "a plan must not change anything")
+	assert.Equal(t, `create tag '4k'
+create custom format 'HDR'
+delete custom format 'x265'
+update delay profile '(no tags)'
+    enableTorrent: (none) -> true
+    enableUsenet: true -> (none)
+create download client 'qbit'
+delete root folder '/movies'
+update naming 'naming'
+    standardMovieFormat: "{Movie Title}" -> "{Movie Title} ({Release Year})"
+`, report.String())
+}
+
+func TestRadarrApplySecrets(t *testing.T) {
+	t.Parallel()
+
+	app, fake := radarrServer(t)
+	snap := &starrsnap.Radarr{DownloadClients: []*radarr.DownloadClientInput{{
+		Name:   "qbit",
+		Fields: []*starr.FieldInput{{Name: "password", Value: starr.MaskedValue}},
+	}}}
+
+	_, err := snap.Plan(t.Context(), app, nil)
+	require.ErrorIs(t, err, starr.ErrMaskedSecret, "a plan must find missing secrets")
+
+	secrets := func(provider, field string) (any, bool) { return provider + "-" + field, true }
+
+	_, err = snap.Apply(t.Context(), app, &starrsnap.ApplyOptions{Secrets: secrets})
+	require.NoError(t, err)
+	assert.Contains(t, fake.Last("POST /api/v3/downloadClient").Body, `"value":"qbit-password"`)
+	assert.Equal(t, starr.MaskedValue, snap.DownloadClients[0].Fields[0].Value, "Apply must not change the snapshot")
+}
+
+func TestRadarrApplyUnresolved(t *testing.T) {
+	t.Parallel()
+
+	app, fake := radarrServer(t)
+	snap := &starrsnap.Radarr{
+		Indexers: []*radarr.IndexerInput{{ID: 4, Name: "nzb", DownloadClientID: 9}},
+	}
+
+	_, err := snap.Apply(t.Context(), app, nil)
+	require.ErrorIs(t, err, starrsnap.ErrUnresolvedID, "a missing download client must not become 'any client'")
+	assert.ErrorContains(t, err, "download client 9")
+	assert.Empty(t, writes(fake))
+
+	snap = &starrsnap.Radarr{QualityProfiles: []*radarr.QualityProfile{{
+		ID: 8, Name: "HD", FormatItems: []*starr.FormatItem{{Format: 6, Name: "HDR", Score: 50}},
+	}}}
+
+	_, err = snap.Apply(t.Context(), app, nil)
+	require.ErrorIs(t, err, starrsnap.ErrUnresolvedID, "a score for a missing custom format must not be sent")
+	assert.ErrorContains(t, err, "custom format 6 (HDR)")
+	assert.Empty(t, writes(fake))
+}
@@ -0,0 +1,428 @@
+package starrsnap
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+)
+
+// Sonarr is a snapshot of a Sonarr instance's configuration.
+type Sonarr struct {
+	Header
+	Tags               []*starr.Tag                  `json:"tags"`
+	CustomFormats      []*sonarr.CustomFormatInput   `json:"customFormats"`
+	QualityProfiles    []*sonarr.QualityProfile      `json:"qualityProfiles"`
+	DelayProfiles      []*sonarr.DelayProfile        `json:"delayProfiles"`
+	ReleaseProfiles    []*sonarr.ReleaseProfile      `json:"releaseProfiles"`
+	Naming             *sonarr.Naming                `json:"naming"`
+	MediaManagement    *sonarr.MediaManagement       `json:"mediaManagement"`
+	DownloadClients    []*sonarr.DownloadClientInput `json:"downloadClients"`
+	Indexers           []*sonarr.IndexerInput        `json:"indexers"`
+	Notifications      []*sonarr.NotificationInput   `json:"notifications"`
+	RootFolders        []*sonarr.RootFolder          `json:"rootFolders"`
+	RemotePathMappings []*starr.RemotePathMapping    `json:"remotePathMappings"`
+}
+
+func (*Sonarr) app() starr.App {
+	return starr.Sonarr
+}
+
+// TakeSonarr captures the configuration of a Sonarr instance.
+func TakeSonarr(ctx context.Context, app *sonarr.Sonarr) (*Sonarr, error) {
+	snap := &Sonarr{Header: Header{Version: Version, App: starr.Sonarr}}
+
+	var err error
+
+	if snap.Tags, err = app.GetTagsContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting tags: %w", err)
+	}
+
+	if err = snap.takeProfiles(ctx, app); err != nil {
+		return nil, err
+	}
+
+	if err = snap.takeProviders(ctx, app); err != nil {
+		return nil, err
+	}
+
+	if snap.Naming, err = app.GetNamingContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting naming: %w", err)
+	}
+
+	if snap.MediaManagement, err = app.GetMediaManagementContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting media management: %w", err)
+	}
+
+	if snap.RootFolders, err = app.GetRootFoldersContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting root folders: %w", err)
+	}
+
+	if snap.RemotePathMappings, err = app.GetRemotePathMappingsContext(ctx); err != nil {
+		return nil, fmt.Errorf("getting remote path mappings: %w", err)
+	}
+
+	snap.normalize()
+
+	return snap, nil
+}
+
+func (s *Sonarr) takeProfiles(ctx context.Context, app *sonarr.Sonarr) error {
+	formats, err := app.GetCustomFormatsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting custom formats: %w", err)
+	}
+
+	s.CustomFormats = inputs(formats, (*sonarr.CustomFormatOutput).ToInput)
+
+	if s.QualityProfiles, err = app.GetQualityProfilesContext(ctx); err != nil {
+		return fmt.Errorf("getting quality profiles: %w", err)
+	}
+
+	if s.DelayProfiles, err = app.GetDelayProfilesContext(ctx); err != nil {
+		return fmt.Errorf("getting delay profiles: %w", err)
+	}
+
+	if s.ReleaseProfiles, err = app.GetReleaseProfilesContext(ctx); err != nil {
+		return fmt.Errorf("getting release profiles: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Sonarr) takeProviders(ctx context.Context, app *sonarr.Sonarr) error {
+	clients, err := app.GetDownloadClientsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting download clients: %w", err)
+	}
+
+	s.DownloadClients = inputs(clients, (*sonarr.DownloadClientOutput).ToInput)
+
+	indexers, err := app.GetIndexersContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting indexers: %w", err)
+	}
+
+	s.Indexers = inputs(indexers, (*sonarr.IndexerOutput).ToInput)
+
+	notifications, err := app.GetNotificationsContext(ctx)
+	if err != nil {
+		return fmt.Errorf("getting notifications: %w", err)
+	}
+
+	s.Notifications = inputs(notifications, (*sonarr.NotificationOutput).ToInput)
+
+	return nil
+}
+
+// normalize sorts everything and drops volatile values, so snapshots are deterministic.
+func (s *Sonarr) normalize() {
+	s.Tags = sortBy(s.Tags, func(t *starr.Tag) string { return t.Label })
+	s.CustomFormats = sortBy(s.CustomFormats, func(f *sonarr.CustomFormatInput) string { return f.Name })
+	s.QualityProfiles = sortBy(s.QualityProfiles, func(p *sonarr.QualityProfile) string { return p.Name })
+	s.ReleaseProfiles = sortBy(s.ReleaseProfiles, func(p *sonarr.ReleaseProfile) string { return p.Name })
+	s.DownloadClients = sortBy(s.DownloadClients, func(c *sonarr.DownloadClientInput) string { return c.Name })
+	s.Indexers = sortBy(s.Indexers, func(i *sonarr.IndexerInput) string { return i.Name })
+	s.Notifications = sortBy(s.Notifications, func(n *sonarr.NotificationInput) string { return n.Name })
+	s.RootFolders = sortBy(s.RootFolders, func(f *sonarr.RootFolder) string { return f.Path })
+	s.RemotePathMappings = sortBy(s.RemotePathMappings, mappingKey)
+	s.DelayProfiles = sortBy(s.DelayProfiles, func(p *sonarr.DelayProfile) int64 { return p.Order })
+
+	for _, profile := range s.QualityProfiles {
+		sortFormatItems(profile.FormatItems)
+	}
+
+	for _, profile := range s.DelayProfiles {
+		slices.Sort(profile.Tags)
+	}
+
+	for _, profile := range s.ReleaseProfiles {
+		slices.Sort(profile.Tags)
+	}
+
+	for _, client := range s.DownloadClients {
+		slices.Sort(client.Tags)
+	}
+
+	for _, indexer := range s.Indexers {
+		slices.Sort(indexer.Tags)
+	}
+
+	for _, notification := range s.Notifications {
+		slices.Sort(notification.Tags)
+	}
+
+	for _, folder := range s.RootFolders {
+		folder.Accessible, folder.FreeSpace, folder.UnmappedFolders = false, 0, nil
+	}
+}
+
+// Plan returns the changes Apply would make to a Sonarr instance, without making them.
+func (s *Sonarr) Plan(ctx context.Context, app *sonarr.Sonarr, opts *ApplyOptions) (*Report, error) {
+	return s.Apply(ctx, app, dryRun(opts))
+}
+
+// Apply makes a Sonarr instance match the snapshot, and returns the changes it made.
+// With the DryRun option, nothing is changed, and the report lists the planned changes.
+// The report is returned with an error too, and lists the changes made before the error.
+func (s *Sonarr) Apply(ctx context.Context, app *sonarr.Sonarr, opts *ApplyOptions) (*Report, error) {
+	live, err := TakeSonarr(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &sonarrRun{want: s, live: live, app: app, opts: opts, report: &Report{}, formatIDs: map[string]int64{}}
+	if run.opts == nil {
+		run.opts = &ApplyOptions{}
+	}
+
+	steps := []func(context.Context) error{
+		run.tags, run.customFormats, run.qualityProfiles, run.delayProfiles,
+		run.downloadClients, run.indexers, run.releaseProfiles, run.notifications,
+		run.rootFolders, run.remotePathMappings, run.settings,
+	}
+
+	for _, step := range steps {
+		if err := step(ctx); err != nil {
+			return run.report, err
+		}
+	}
+
+	return run.report, nil
+}
+
+// sonarrRun holds the state of one Sonarr snapshot being applied.
+type sonarrRun struct {
+	want, live *Sonarr
+	app        *sonarr.Sonarr
+	opts       *ApplyOptions
+	report     *Report
+	tagIDs     idMap
+	formatIDs  map[string]int64
+	labels     map[int]string
+	clientIDs  idMap
+	indexerIDs idMap
+}
+
+func (r *sonarrRun) tags(ctx context.Context) (err error) {
+	r.tagIDs, r.labels, err = applyTags(ctx, r.want.Tags, r.live.Tags, r.app.AddTagContext, r.opts, r.report)
+	return err
+}
+
+func (r *sonarrRun) customFormats(ctx context.Context) error {
+	_, err := (&list[*sonarr.CustomFormatInput]{
+		kind: "custom format",
+		want: r.want.CustomFormats,
+		live: r.live.CustomFormats,
+		keys: r.formatIDs,
+		key:  func(f *sonarr.CustomFormatInput) string { return f.Name },
+		id:   func(f *sonarr.CustomFormatInput) *int64 { return &f.ID },
+		add: func(ctx context.Context, f *sonarr.CustomFormatInput) (int64, error) {
+			return created(func(o *sonarr.CustomFormatOutput) int64 { return o.ID })(r.app.AddCustomFormatContext(ctx, f))
+		},
+		update: func(ctx context.Context, f *sonarr.CustomFormatInput) error {
+			_, err := r.app.UpdateCustomFormatContext(ctx, f)
+			return err
+		},
+		remove: r.app.DeleteCustomFormatContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *sonarrRun) qualityProfiles(ctx context.Context) error {
+	_, err := (&list[*sonarr.QualityProfile]{
+		kind: "quality profile",
+		want: r.want.QualityProfiles,
+		live: r.live.QualityProfiles,
+		key:  func(p *sonarr.QualityProfile) string { return p.Name },
+		id:   func(p *sonarr.QualityProfile) *int64 { return &p.ID },
+		resolve: func(p *sonarr.QualityProfile) (err error) {
+			p.FormatItems, err = formatItems(p.FormatItems, r.formatIDs)
+			return err
+		},
+		add: func(ctx context.Context, p *sonarr.QualityProfile) (int64, error) {
+			return created(func(o *sonarr.QualityProfile) int64 { return o.ID })(r.app.AddQualityProfileContext(ctx, p))
+		},
+		update: func(ctx context.Context, p *sonarr.QualityProfile) error {
+			_, err := r.app.UpdateQualityProfileContext(ctx, p)
+			return err
+		},
+		remove: r.app.DeleteQualityProfileContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *sonarrRun) delayProfiles(ctx context.Context) error {
+	_, err := (&list[*sonarr.DelayProfile]{
+		kind:  "delay profile",
+		want:  r.want.DelayProfiles,
+		live:  r.live.DelayProfiles,
+		key:   func(p *sonarr.DelayProfile) string { return tagKey(p.Tags, r.labels) },
+		id:    func(p *sonarr.DelayProfile) *int64 { return &p.ID },
+		remap: func(p *sonarr.DelayProfile) { p.Tags = r.tagIDs.tags(p.Tags) },
+		add: func(ctx context.Context, p *sonarr.DelayProfile) (int64, error) {
+			return created(func(o *sonarr.DelayProfile) int64 { return o.ID })(r.app.AddDelayProfileContext(ctx, p))
+		},
+		update: func(ctx context.Context, p *sonarr.DelayProfile) error {
+			_, err := r.app.UpdateDelayProfileContext(ctx, p)
+			return err
+		},
+		remove: r.app.DeleteDelayProfileContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *sonarrRun) downloadClients(ctx context.Context) (err error) {
+	r.clientIDs, err = (&list[*sonarr.DownloadClientInput]{
+		kind:   "download client",
+		want:   r.want.DownloadClients,
+		live:   r.live.DownloadClients,
+		key:    func(c *sonarr.DownloadClientInput) string { return c.Name },
+		id:     func(c *sonarr.DownloadClientInput) *int64 { return &c.ID },
+		fields: func(c *sonarr.DownloadClientInput) (string, []*starr.FieldInput) { return c.Name, c.Fields },
+		remap:  func(c *sonarr.DownloadClientInput) { c.Tags = r.tagIDs.tags(c.Tags) },
+		add: func(ctx context.Context, c *sonarr.DownloadClientInput) (int64, error) {
+			return created(func(o *sonarr.DownloadClientOutput) int64 { return o.ID })(r.app.AddDownloadClientContext(ctx, c))
+		},
+		update: func(ctx context.Context, c *sonarr.DownloadClientInput) error {
+			_, err := r.app.UpdateDownloadClientContext(ctx, c, r.opts.ForceSave)
+			return err
+		},
+		remove: r.app.DeleteDownloadClientContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *sonarrRun) indexers(ctx context.Context) (err error) {
+	r.indexerIDs, err = (&list[*sonarr.IndexerInput]{
+		kind:   "indexer",
+		want:   r.want.Indexers,
+		live:   r.live.Indexers,
+		key:    func(i *sonarr.IndexerInput) string { return i.Name },
+		id:     func(i *sonarr.IndexerInput) *int64 { return &i.ID },
+		fields: func(i *sonarr.IndexerInput) (string, []*starr.FieldInput) { return i.Name, i.Fields },
+		remap:  func(i *sonarr.IndexerInput) { i.Tags = r.tagIDs.tags(i.Tags) },
+		resolve: func(i *sonarr.IndexerInput) (err error) {
+			i.DownloadClientID, err = r.clientIDs.get("download client", i.DownloadClientID)
+			return err
+		},
+		add: func(ctx context.Context, i *sonarr.IndexerInput) (int64, error) {
+			return created(func(o *sonarr.IndexerOutput) int64 { return o.ID })(r.app.AddIndexerContext(ctx, i))
+		},
+		update: func(ctx context.Context, i *sonarr.IndexerInput) error {
+			_, err := r.app.UpdateIndexerContext(ctx, i, r.opts.ForceSave)
+			return err
+		},
+		remove: r.app.DeleteIndexerContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *sonarrRun) releaseProfiles(ctx context.Context) error {
+	_, err := (&list[*sonarr.ReleaseProfile]{
+		kind:  "release profile",
+		want:  r.want.ReleaseProfiles,
+		live:  r.live.ReleaseProfiles,
+		key:   func(p *sonarr.ReleaseProfile) string { return p.Name },
+		id:    func(p *sonarr.ReleaseProfile) *int64 { return &p.ID },
+		remap: func(p *sonarr.ReleaseProfile) { p.Tags = r.tagIDs.tags(p.Tags) },
+		resolve: func(p *sonarr.ReleaseProfile) (err error) {
+			p.IndexerID, err = r.indexerIDs.get("indexer", p.IndexerID)
+			return err
+		},
+		add: func(ctx context.Context, p *sonarr.ReleaseProfile) (int64, error) {
+			return created(func(o *sonarr.ReleaseProfile) int64 { return o.ID })(r.app.AddReleaseProfileContext(ctx, p))
+		},
+		update: func(ctx context.Context, p *sonarr.ReleaseProfile) error {
+			_, err := r.app.UpdateReleaseProfileContext(ctx, p)
+			return err
+		},
+		remove: r.app.DeleteReleaseProfileContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *sonarrRun) notifications(ctx context.Context) error {
+	_, err := (&list[*sonarr.NotificationInput]{
+		kind:   "notification",
+		want:   r.want.Notifications,
+		live:   r.live.Notifications,
+		key:    func(n *sonarr.NotificationInput) string { return n.Name },
+		id:     func(n *sonarr.NotificationInput) *int64 { return &n.ID },
+		fields: func(n *sonarr.NotificationInput) (string, []*starr.FieldInput) { return n.Name, n.Fields },
+		remap:  func(n *sonarr.NotificationInput) { n.Tags = r.tagIDs.tags(n.Tags) },
+		add: func(ctx context.Context, n *sonarr.NotificationInput) (int64, error) {
+			return created(func(o *sonarr.NotificationOutput) int64 { return o.ID })(r.app.AddNotificationContext(ctx, n))
+		},
+		update: func(ctx context.Context, n *sonarr.NotificationInput) error {
+			_, err := r.app.UpdateNotificationContext(ctx, n)
+			return err
+		},
+		remove: r.app.DeleteNotificationContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *sonarrRun) rootFolders(ctx context.Context) error {
+	_, err := (&list[*sonarr.RootFolder]{
+		kind: "root folder",
+		want: r.want.RootFolders,
+		live: r.live.RootFolders,
+		key:  func(f *sonarr.RootFolder) string { return f.Path },
+		id:   func(f *sonarr.RootFolder) *int64 { return &f.ID },
+		add: func(ctx context.Context, f *sonarr.RootFolder) (int64, error) {
+			return created(func(o *sonarr.RootFolder) int64 { return o.ID })(r.app.AddRootFolderContext(ctx, f))
+		},
+		remove: r.app.DeleteRootFolderContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *sonarrRun) remotePathMappings(ctx context.Context) error {
+	_, err := (&list[*starr.RemotePathMapping]{
+		kind: "remote path mapping",
+		want: r.want.RemotePathMappings,
+		live: r.live.RemotePathMappings,
+		key:  mappingKey,
+		id:   func(m *starr.RemotePathMapping) *int64 { return &m.ID },
+		add: func(ctx context.Context, m *starr.RemotePathMapping) (int64, error) {
+			return created(mappingID)(r.app.AddRemotePathMappingContext(ctx, m))
+		},
+		update: func(ctx context.Context, m *starr.RemotePathMapping) error {
+			_, err := r.app.UpdateRemotePathMappingContext(ctx, m)
+			return err
+		},
+		remove: r.app.DeleteRemotePathMappingContext,
+	}).apply(ctx, r.opts, r.report)
+
+	return err
+}
+
+func (r *sonarrRun) settings(ctx context.Context) error {
+	err := applyOne(ctx, "naming", r.want.Naming, r.live.Naming,
+		func(n *sonarr.Naming) *int64 { return &n.ID },
+		func(ctx context.Context, n *sonarr.Naming) error {
+			_, err := r.app.UpdateNamingContext(ctx, n)
+			return err
+		}, r.opts, r.report)
+	if err != nil {
+		return err
+	}
+
+	return applyOne(ctx, "media management", r.want.MediaManagement, r.live.MediaManagement,
+		func(m *sonarr.MediaManagement) *int64 { return &m.ID },
+		func(ctx context.Context, m *sonarr.MediaManagement) error {
+			_, err := r.app.UpdateMediaManagementContext(ctx, m)
+			return err
+		}, r.opts, r.report)
+}
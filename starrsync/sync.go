@@ -0,0 +1,366 @@
+// Package starrsync triggers Prowlarr's indexer sync to its connected applications,
+// and checks each application for the indexers Prowlarr should have synced to it.
+//
+// Prowlarr adds its indexers to Radarr, Sonarr, Lidarr and Readarr with a base URL
+// that points back to Prowlarr. Those indexers are found by that URL, so indexers
+// added to an application by hand are never reported.
+//
+// To copy custom formats and their scores between Radarr and Sonarr instances,
+// use starrsnap.SyncFormats.
+package starrsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/sonarr"
+)
+
+// CommandName is the Prowlarr command that syncs indexers to every connected application.
+const CommandName = "ApplicationIndexerSync"
+
+// Defaults for Options.
+const (
+	DefaultTimeout  = 5 * time.Minute
+	DefaultInterval = 2 * time.Second
+)
+
+// Errors returned by Sync.
+var (
+	// ErrSyncFailed is returned when the sync command does not complete.
+	ErrSyncFailed = errors.New("indexer sync command did not complete")
+	// ErrUnknownApp is returned when an App's name does not match an application in Prowlarr.
+	ErrUnknownApp = errors.New("no Prowlarr application with this name")
+)
+
+// Options control Sync. Nil options are the same as empty options.
+type Options struct {
+	// NoSync skips the sync command, and only checks the applications.
+	NoSync bool
+	// Timeout is how long to wait for the sync command. Defaults to DefaultTimeout.
+	Timeout time.Duration
+	// Interval is how often the command status is checked. Defaults to DefaultInterval.
+	Interval time.Duration
+}
+
+// App is a Radarr, Sonarr, Lidarr or Readarr instance connected to Prowlarr.
+// Create one with the function named after the app.
+type App struct {
+	// Name is the name of the application in Prowlarr.
+	Name     string
+	indexers func(context.Context) ([]*Indexer, error)
+}
+
+// Indexer is an indexer in a connected application.
+type Indexer struct {
+	ID   int64
+	Name string
+	// ProwlarrID is the ID of the Prowlarr indexer this indexer was synced from.
+	ProwlarrID int64
+	// Reason is filled in on stale indexers.
+	Reason string
+	fields []*starr.FieldOutput
+}
+
+// Report is the result of Sync.
+type Report struct {
+	// Command is the finished sync command. Nil with Options.NoSync.
+	Command *prowlarr.CommandResponse
+	Apps    []*AppReport
+}
+
+// AppReport lists the sync state of one application.
+type AppReport struct {
+	Name          string
+	ApplicationID int64
+	SyncLevel     string
+	// Synced are the Prowlarr-managed indexers that match Prowlarr.
+	Synced []*Indexer
+	// Missing are the Prowlarr indexers that should be in the application, but are not.
+	Missing []*prowlarr.IndexerOutput
+	// Stale are the Prowlarr-managed indexers that Prowlarr should have removed or updated.
+	Stale []*Indexer
+}
+
+// OK returns true if no application has missing or stale indexers.
+func (r *Report) OK() bool {
+	for _, app := range r.Apps {
+		if len(app.Missing) > 0 || len(app.Stale) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Radarr wraps a Radarr instance for Sync. Name is the application's name in Prowlarr.
+func Radarr(name string, app *radarr.Radarr) *App {
+	return &App{Name: name, indexers: wrap(app.GetIndexersContext, func(i *radarr.IndexerOutput) *Indexer {
+		return &Indexer{ID: i.ID, Name: i.Name, fields: i.Fields}
+	})}
+}
+
+// Sonarr wraps a Sonarr instance for Sync. Name is the application's name in Prowlarr.
+func Sonarr(name string, app *sonarr.Sonarr) *App {
+	return &App{Name: name, indexers: wrap(app.GetIndexersContext, func(i *sonarr.IndexerOutput) *Indexer {
+		return &Indexer{ID: i.ID, Name: i.Name, fields: i.Fields}
+	})}
+}
+
+// Lidarr wraps a Lidarr instance for Sync. Name is the application's name in Prowlarr.
+func Lidarr(name string, app *lidarr.Lidarr) *App {
+	return &App{Name: name, indexers: wrap(app.GetIndexersContext, func(i *lidarr.IndexerOutput) *Indexer {
+		return &Indexer{ID: i.ID, Name: i.Name, fields: i.Fields}
+	})}
+}
+
+// Readarr wraps a Readarr instance for Sync. Name is the application's name in Prowlarr.
+func Readarr(name string, app *readarr.Readarr) *App {
+	return &App{Name: name, indexers: wrap(app.GetIndexersContext, func(i *readarr.IndexerOutput) *Indexer {
+		return &Indexer{ID: i.ID, Name: i.Name, fields: i.Fields}
+	})}
+}
+
+func wrap[O any](
+	get func(context.Context) ([]*O, error), convert func(*O) *Indexer,
+) func(context.Context) ([]*Indexer, error) {
+	return func(ctx context.Context) ([]*Indexer, error) {
+		list, err := get(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting indexers: %w", err)
+		}
+
+		output := make([]*Indexer, len(list))
+		for idx, item := range list {
+			output[idx] = convert(item)
+		}
+
+		return output, nil
+	}
+}
+
+// Sync triggers Prowlarr's indexer sync, waits for it to finish, and then checks the indexers in each app.
+// An indexer is expected in an app when it is enabled in Prowlarr, shares a tag with the application
+// (or the application has no tags), and has a category in the application's sync categories.
+// Apps with the disabled sync level are not checked. The report is returned with an error too,
+// and lists the apps checked before the error.
+func Sync(ctx context.Context, app *prowlarr.Prowlarr, opts *Options, apps ...*App) (*Report, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	report := &Report{}
+
+	if !opts.NoSync {
+		var err error
+		if report.Command, err = runCommand(ctx, app, opts); err != nil {
+			return report, err
+		}
+	}
+
+	indexers, err := app.GetIndexersContext(ctx)
+	if err != nil {
+		return report, fmt.Errorf("getting Prowlarr indexers: %w", err)
+	}
+
+	applications, err := app.GetApplicationsContext(ctx)
+	if err != nil {
+		return report, fmt.Errorf("getting Prowlarr applications: %w", err)
+	}
+
+	for _, target := range apps {
+		idx := slices.IndexFunc(applications, func(a *prowlarr.ApplicationOutput) bool { return a.Name == target.Name })
+		if idx == -1 {
+			return report, fmt.Errorf("%w: %s", ErrUnknownApp, target.Name)
+		}
+
+		appReport, err := check(ctx, target, applications[idx], indexers)
+		if err != nil {
+			return report, fmt.Errorf("%s: %w", target.Name, err)
+		}
+
+		report.Apps = append(report.Apps, appReport)
+	}
+
+	return report, nil
+}
+
+// runCommand sends the sync command, and waits for it to finish.
+func runCommand(ctx context.Context, app *prowlarr.Prowlarr, opts *Options) (*prowlarr.CommandResponse, error) {
+	timeout, interval := opts.Timeout, opts.Interval
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd, err := app.SendCommandContext(ctx, &prowlarr.CommandRequest{Name: CommandName})
+	if err != nil {
+		return nil, fmt.Errorf("sending sync command: %w", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		switch cmd.Status {
+		case "completed":
+			return cmd, nil
+		case "failed", "aborted", "cancelled", "orphaned":
+			return cmd, fmt.Errorf("%w: %s: %s", ErrSyncFailed, cmd.Status, cmd.Message)
+		}
+
+		select {
+		case <-ctx.Done():
+			return cmd, fmt.Errorf("%w: %s: %w", ErrSyncFailed, cmd.Status, ctx.Err())
+		case <-ticker.C:
+		}
+
+		status, err := app.GetCommandStatusContext(ctx, cmd.ID)
+		if err != nil && ctx.Err() != nil {
+			return cmd, fmt.Errorf("%w: %s: %w", ErrSyncFailed, cmd.Status, ctx.Err())
+		} else if err != nil {
+			return cmd, fmt.Errorf("getting sync command %d: %w", cmd.ID, err)
+		}
+
+		cmd = status
+	}
+}
+
+// check compares the indexers in one app with the indexers Prowlarr should have synced to it.
+func check(
+	ctx context.Context, target *App, application *prowlarr.ApplicationOutput, indexers []*prowlarr.IndexerOutput,
+) (*AppReport, error) {
+	report := &AppReport{Name: target.Name, ApplicationID: application.ID, SyncLevel: application.SyncLevel}
+	if application.SyncLevel == prowlarr.SyncLevelDisabled {
+		return report, nil
+	}
+
+	list, err := target.indexers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prowlarrURL, _ := field(application.Fields, "prowlarrUrl").(string)
+	categories := int64s(field(application.Fields, "syncCategories"))
+	found := make(map[int64]bool)
+
+	for _, indexer := range list {
+		if indexer.ProwlarrID = managedID(prowlarrURL, indexer.fields); indexer.ProwlarrID == 0 {
+			continue // Not added by this Prowlarr.
+		}
+
+		idx := slices.IndexFunc(indexers, func(i *prowlarr.IndexerOutput) bool { return i.ID == indexer.ProwlarrID })
+
+		switch {
+		case idx == -1:
+			indexer.Reason = "removed from Prowlarr"
+		case !expected(indexers[idx], application, categories):
+			indexer.Reason = "not synced to this application"
+		case found[indexer.ProwlarrID]:
+			indexer.Reason = "duplicate"
+		case indexer.Name != indexers[idx].Name+" (Prowlarr)":
+			indexer.Reason = "renamed in Prowlarr to " + indexers[idx].Name
+		default:
+			found[indexer.ProwlarrID] = true
+			report.Synced = append(report.Synced, indexer)
+
+			continue
+		}
+
+		report.Stale = append(report.Stale, indexer)
+	}
+
+	for _, indexer := range indexers {
+		if !found[indexer.ID] && expected(indexer, application, categories) {
+			report.Missing = append(report.Missing, indexer)
+		}
+	}
+
+	return report, nil
+}
+
+// expected returns true if Prowlarr syncs an indexer to an application.
+func expected(indexer *prowlarr.IndexerOutput, application *prowlarr.ApplicationOutput, categories []int64) bool {
+	if !indexer.Enable {
+		return false
+	}
+
+	if len(application.Tags) > 0 && !slices.ContainsFunc(indexer.Tags, func(tag int) bool {
+		return slices.Contains(application.Tags, tag)
+	}) {
+		return false
+	}
+
+	if len(categories) == 0 || indexer.Capabilities == nil {
+		return true
+	}
+
+	return slices.ContainsFunc(categoryIDs(indexer.Capabilities.Categories), func(id int64) bool {
+		return slices.Contains(categories, id)
+	})
+}
+
+func categoryIDs(categories []*prowlarr.Categories) []int64 {
+	ids := []int64{}
+	for _, cat := range categories {
+		ids = append(ids, cat.ID)
+		ids = append(ids, categoryIDs(cat.SubCategories)...)
+	}
+
+	return ids
+}
+
+// managedID returns the Prowlarr indexer ID from an indexer's base URL, like http://prowlarr:9696/5/.
+// Returns 0 if the base URL does not point to Prowlarr.
+func managedID(prowlarrURL string, fields []*starr.FieldOutput) int64 {
+	baseURL, _ := field(fields, "baseUrl").(string)
+	prefix := strings.TrimSuffix(prowlarrURL, "/") + "/"
+
+	if prowlarrURL == "" || !strings.HasPrefix(baseURL, prefix) {
+		return 0
+	}
+
+	id, _ := strconv.ParseInt(strings.Trim(strings.TrimPrefix(baseURL, prefix), "/"), 10, 64)
+
+	return id
+}
+
+func field(fields []*starr.FieldOutput, name string) any {
+	for _, field := range fields {
+		if field.Name == name {
+			return field.Value
+		}
+	}
+
+	return nil
+}
+
+// int64s converts a json list of numbers into int64s.
+func int64s(value any) []int64 {
+	list, _ := value.([]any)
+	output := make([]int64, 0, len(list))
+
+	for _, item := range list {
+		if num, ok := item.(float64); ok {
+			output = append(output, int64(num))
+		}
+	}
+
+	return output
+}
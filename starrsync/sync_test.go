@@ -0,0 +1,122 @@
+package starrsync_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/starrsync"
+	"golift.io/starr/starrtest"
+)
+
+func prowlarrServer(t *testing.T, status string) (*prowlarr.Prowlarr, *starrtest.MockServer) {
+	t.Helper()
+
+	fake := starrtest.NewMockServer(t, map[string][]string{
+		"POST /api/v1/command":  {`{"id":7,"name":"ApplicationIndexerSync","status":"queued"}`},
+		"GET /api/v1/command/7": {`{"id":7,"name":"ApplicationIndexerSync","status":"` + status + `"}`},
+		"GET /api/v1/indexer": {`[
+			{"id":1,"name":"Nyaa","enable":true,"tags":[],"capabilities":{"categories":[{"id":2000}]}},
+			{"id":2,"name":"Off","enable":false,"tags":[],"capabilities":{"categories":[{"id":2000}]}},
+			{"id":3,"name":"HD","enable":true,"tags":[5],"capabilities":{"categories":[{"id":2000,
+				"subCategories":[{"id":2040}]}]}},
+			{"id":4,"name":"Audio","enable":true,"tags":[],"capabilities":{"categories":[{"id":3000}]}},
+			{"id":5,"name":"Renamed","enable":true,"tags":[],"capabilities":{"categories":[{"id":2040}]}}]`},
+		"GET /api/v1/applications": {`[{"id":1,"name":"Movies","syncLevel":"fullSync","tags":[],"fields":[
+			{"name":"prowlarrUrl","value":"http://prowlarr:9696"},
+			{"name":"baseUrl","value":"http://movies:7878"},
+			{"name":"syncCategories","value":[2000,2040]}]},
+			{"id":2,"name":"Off","syncLevel":"disabled","fields":[]}]`},
+	})
+
+	return prowlarr.New(starr.New("apikey", fake.URL, 0)), fake
+}
+
+func radarrServer(t *testing.T) *radarr.Radarr {
+	t.Helper()
+
+	fake := starrtest.NewMockServer(t, map[string][]string{
+		"GET /api/v3/indexer": {`[
+			{"id":10,"name":"Nyaa (Prowlarr)","fields":[{"name":"baseUrl","value":"http://prowlarr:9696/1/"}]},
+			{"id":11,"name":"Off (Prowlarr)","fields":[{"name":"baseUrl","value":"http://prowlarr:9696/2/"}]},
+			{"id":12,"name":"Gone (Prowlarr)","fields":[{"name":"baseUrl","value":"http://prowlarr:9696/9/"}]},
+			{"id":13,"name":"Old (Prowlarr)","fields":[{"name":"baseUrl","value":"http://prowlarr:9696/5/"}]},
+			{"id":14,"name":"Manual","fields":[{"name":"baseUrl","value":"https://indexer.example"}]}]`},
+	})
+
+	return radarr.New(starr.New("apikey", fake.URL, 0))
+}
+
+func TestSync(t *testing.T) {
+	t.Parallel()
+
+	app, fake := prowlarrServer(t, "completed")
+	movies := starrsync.Radarr("Movies", radarrServer(t))
+
+	report, err := starrsync.Sync(t.Context(), app, &starrsync.Options{Interval: time.Millisecond}, movies)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fake.Count("POST /api/v1/command"))
+	assert.Equal(t, 1, fake.Count("GET /api/v1/command/7"))
+	assert.Equal(t, "completed", report.Command.Status)
+	assert.False(t, report.OK())
+	require.Len(t, report.Apps, 1)
+
+	movieReport := report.Apps[0]
+	assert.Equal(t, int64(1), movieReport.ApplicationID)
+	require.Len(t, movieReport.Synced, 1)
+	assert.Equal(t, int64(10), movieReport.Synced[0].ID)
+	assert.Equal(t, int64(1), movieReport.Synced[0].ProwlarrID)
+
+	missing := []int64{}
+	for _, indexer := range movieReport.Missing {
+		missing = append(missing, indexer.ID)
+	}
+
+	assert.Equal(t, []int64{3, 5}, missing, "disabled and audio indexers must not be expected")
+
+	stale := map[int64]string{}
+	for _, indexer := range movieReport.Stale {
+		stale[indexer.ID] = indexer.Reason
+	}
+
+	assert.Equal(t, map[int64]string{
+		11: "not synced to this application",
+		12: "removed from Prowlarr",
+		13: "renamed in Prowlarr to Renamed",
+	}, stale, "manually added indexers must not be reported")
+}
+
+func TestSyncDisabled(t *testing.T) {
+	t.Parallel()
+
+	app, fake := prowlarrServer(t, "completed")
+	off := starrsync.Radarr("Off", radarr.New(starr.New("apikey", "http://127.0.0.1:1", 0)))
+
+	report, err := starrsync.Sync(t.Context(), app, &starrsync.Options{NoSync: true}, off)
+	require.NoError(t, err, "disabled applications must not be checked")
+	assert.Zero(t, fake.Count("POST /api/v1/command"))
+	assert.Nil(t, report.Command)
+	assert.True(t, report.OK())
+}
+
+func TestSyncErrors(t *testing.T) {
+	t.Parallel()
+
+	app, _ := prowlarrServer(t, "failed")
+	movies := starrsync.Radarr("Movies", radarrServer(t))
+
+	_, err := starrsync.Sync(t.Context(), app, &starrsync.Options{Interval: time.Millisecond}, movies)
+	require.ErrorIs(t, err, starrsync.ErrSyncFailed)
+
+	app, _ = prowlarrServer(t, "started")
+	opts := &starrsync.Options{Interval: time.Millisecond, Timeout: 20 * time.Millisecond}
+	_, err = starrsync.Sync(t.Context(), app, opts)
+	require.ErrorIs(t, err, starrsync.ErrSyncFailed, "a sync that never finishes must time out")
+
+	_, err = starrsync.Sync(t.Context(), app, &starrsync.Options{NoSync: true}, starrsync.Radarr("Nope", nil))
+	require.ErrorIs(t, err, starrsync.ErrUnknownApp)
+}
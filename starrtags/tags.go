@@ -0,0 +1,405 @@
+// Package starrtags builds a graph of the resources that use each tag in a Starr app,
+// finds the tags nothing uses, and renames or merges tags.
+//
+// The graph comes from each app's tag detail endpoint. Merging a tag edits the tags
+// list on every resource that uses it, through that resource's own API path. Every
+// other field on the resource is sent back exactly as the app returned it.
+package starrtags
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"golift.io/starr"
+	"golift.io/starr/lidarr"
+	"golift.io/starr/prowlarr"
+	"golift.io/starr/radarr"
+	"golift.io/starr/readarr"
+	"golift.io/starr/sonarr"
+)
+
+// Kinds of resources that use tags. Each kind is the resource's API path.
+const (
+	KindSeries         = "series"
+	KindMovie          = "movie"
+	KindArtist         = "artist"
+	KindAuthor         = "author"
+	KindIndexer        = "indexer"
+	KindIndexerProxy   = "indexerproxy"
+	KindDownloadClient = "downloadclient"
+	KindNotification   = "notification"
+	KindImportList     = "importlist"
+	KindApplication    = "applications"
+	KindDelayProfile   = "delayprofile"
+	KindReleaseProfile = "releaseprofile"
+	KindAutoTag        = "autotagging"
+)
+
+// Errors returned by this package.
+var (
+	// ErrUnknownTag is returned when a tag ID does not exist in the app.
+	ErrUnknownTag = errors.New("no tag with this ID")
+	// ErrLabelExists is returned when renaming a tag to the label of another tag. Merge them instead.
+	ErrLabelExists = errors.New("another tag has this label")
+	// ErrTagInUse is returned when a merged tag is still used after every resource was updated.
+	ErrTagInUse = errors.New("tag is still in use")
+)
+
+// App is a Radarr, Sonarr, Lidarr, Readarr or Prowlarr instance.
+// Create one with the function named after the app.
+type App struct {
+	api     starr.APIer
+	version string
+	details func(context.Context) ([]*Tag, error)
+}
+
+// Tag is one tag and the resources that use it.
+type Tag struct {
+	ID    int
+	Label string
+	// Used maps a resource kind, like KindSeries, to the IDs of the resources with this tag.
+	// Kinds without any resources are left out.
+	Used map[string][]int
+}
+
+// Graph is every tag in an app, and what uses each one.
+type Graph struct {
+	Tags []*Tag
+}
+
+// Radarr wraps a Radarr instance.
+func Radarr(app *radarr.Radarr) *App {
+	return &App{api: app.APIer, version: radarr.APIver, details: wrap(app.GetTagDetailsContext,
+		func(t *radarr.TagDetails) *Tag {
+			return newTag(t.ID, t.Label, map[string][]int{
+				KindMovie:          t.MovieIDs,
+				KindIndexer:        t.IndexerIDs,
+				KindIndexerProxy:   t.IndexerProxyIDs,
+				KindDownloadClient: t.DownloadClientIDs,
+				KindNotification:   t.NotificationIDs,
+				KindImportList:     t.ImportListIDs,
+				KindDelayProfile:   t.DelayProfileIDs,
+				KindReleaseProfile: t.ReleaseProfileIDs,
+				KindAutoTag:        t.AutoTagIDs,
+			})
+		})}
+}
+
+// Sonarr wraps a Sonarr instance.
+func Sonarr(app *sonarr.Sonarr) *App {
+	return &App{api: app.APIer, version: sonarr.APIver, details: wrap(app.GetTagDetailsContext,
+		func(t *sonarr.TagDetails) *Tag {
+			return newTag(t.ID, t.Label, map[string][]int{
+				KindSeries:         t.SeriesIDs,
+				KindIndexer:        t.IndexerIDs,
+				KindDownloadClient: t.DownloadClientIDs,
+				KindNotification:   t.NotificationIDs,
+				KindImportList:     t.ImportListIDs,
+				KindDelayProfile:   t.DelayProfileIDs,
+				KindReleaseProfile: t.RestrictionIDs,
+				KindAutoTag:        t.AutoTagIDs,
+			})
+		})}
+}
+
+// Lidarr wraps a Lidarr instance.
+func Lidarr(app *lidarr.Lidarr) *App {
+	return &App{api: app.APIer, version: lidarr.APIver, details: wrap(app.GetTagDetailsContext,
+		func(t *lidarr.TagDetails) *Tag {
+			return newTag(t.ID, t.Label, map[string][]int{
+				KindArtist:         t.ArtistIDs,
+				KindIndexer:        t.IndexerIDs,
+				KindIndexerProxy:   t.IndexerProxyIDs,
+				KindDownloadClient: t.DownloadClientIDs,
+				KindNotification:   t.NotificationIDs,
+				KindImportList:     t.ImportListIDs,
+				KindDelayProfile:   t.DelayProfileIDs,
+				KindReleaseProfile: t.RestrictionIDs,
+				KindAutoTag:        t.AutoTagIDs,
+			})
+		})}
+}
+
+// Readarr wraps a Readarr instance.
+func Readarr(app *readarr.Readarr) *App {
+	return &App{api: app.APIer, version: readarr.APIver, details: wrap(app.GetTagDetailsContext,
+		func(t *readarr.TagDetails) *Tag {
+			return newTag(t.ID, t.Label, map[string][]int{
+				KindAuthor:         t.AuthorIDs,
+				KindIndexer:        t.IndexerIDs,
+				KindIndexerProxy:   t.IndexerProxyIDs,
+				KindDownloadClient: t.DownloadClientIDs,
+				KindNotification:   t.NotificationIDs,
+				KindImportList:     t.ImportListIDs,
+				KindDelayProfile:   t.DelayProfileIDs,
+				KindReleaseProfile: t.RestrictionIDs,
+				KindAutoTag:        t.AutoTagIDs,
+			})
+		})}
+}
+
+// Prowlarr wraps a Prowlarr instance.
+func Prowlarr(app *prowlarr.Prowlarr) *App {
+	return &App{api: app.APIer, version: prowlarr.APIver, details: wrap(app.GetTagDetailsContext,
+		func(t *prowlarr.TagDetails) *Tag {
+			return newTag(t.ID, t.Label, map[string][]int{
+				KindIndexer:        t.IndexerIDs,
+				KindIndexerProxy:   t.IndexerProxyIDs,
+				KindDownloadClient: t.DownloadClientIDs,
+				KindNotification:   t.NotificationIDs,
+				KindApplication:    t.ApplicationIDs,
+			})
+		})}
+}
+
+func wrap[D any](
+	get func(context.Context) ([]*D, error), convert func(*D) *Tag,
+) func(context.Context) ([]*Tag, error) {
+	return func(ctx context.Context) ([]*Tag, error) {
+		list, err := get(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting tag details: %w", err)
+		}
+
+		output := make([]*Tag, len(list))
+		for idx, item := range list {
+			output[idx] = convert(item)
+		}
+
+		return output, nil
+	}
+}
+
+func newTag(id int, label string, used map[string][]int) *Tag {
+	for kind, ids := range used {
+		if len(ids) == 0 {
+			delete(used, kind)
+		}
+	}
+
+	return &Tag{ID: id, Label: label, Used: used}
+}
+
+// Count returns the number of resources that use the tag.
+func (t *Tag) Count() int {
+	count := 0
+	for _, ids := range t.Used {
+		count += len(ids)
+	}
+
+	return count
+}
+
+// Orphan returns true if nothing uses the tag.
+func (t *Tag) Orphan() bool {
+	return t.Count() == 0
+}
+
+// Tag returns the tag with this ID, or nil.
+func (g *Graph) Tag(id int) *Tag {
+	idx := slices.IndexFunc(g.Tags, func(t *Tag) bool { return t.ID == id })
+	if idx == -1 {
+		return nil
+	}
+
+	return g.Tags[idx]
+}
+
+// Label returns the tag with this label, or nil. Labels are not case sensitive.
+func (g *Graph) Label(label string) *Tag {
+	idx := slices.IndexFunc(g.Tags, func(t *Tag) bool { return strings.EqualFold(t.Label, label) })
+	if idx == -1 {
+		return nil
+	}
+
+	return g.Tags[idx]
+}
+
+// Orphans returns the tags nothing uses.
+func (g *Graph) Orphans() []*Tag {
+	var orphans []*Tag
+
+	for _, tag := range g.Tags {
+		if tag.Orphan() {
+			orphans = append(orphans, tag)
+		}
+	}
+
+	return orphans
+}
+
+// Build returns the tag usage graph for an app.
+func Build(ctx context.Context, app *App) (*Graph, error) {
+	tags, err := app.details(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Graph{Tags: tags}, nil
+}
+
+// Rename changes a tag's label. It returns ErrLabelExists if another tag already has the label.
+func Rename(ctx context.Context, app *App, tagID int, label string) (*starr.Tag, error) {
+	graph, err := Build(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	if graph.Tag(tagID) == nil {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownTag, tagID)
+	}
+
+	if other := graph.Label(label); other != nil && other.ID != tagID {
+		return nil, fmt.Errorf("%w: %s (%d)", ErrLabelExists, other.Label, other.ID)
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(&starr.Tag{ID: tagID, Label: label}); err != nil {
+		return nil, fmt.Errorf("json.Marshal(tag): %w", err)
+	}
+
+	var output starr.Tag
+
+	req := starr.Request{URI: path.Join(app.version, "tag", starr.Str(tagID)), Body: &body}
+	if err := app.api.PutInto(ctx, req, &output); err != nil {
+		return nil, fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return &output, nil
+}
+
+// Merge moves every resource from one tag to another, and then deletes the first tag.
+// The first tag is only deleted if the app reports that nothing uses it anymore;
+// otherwise ErrTagInUse is returned. Merging a tag into itself does nothing.
+func Merge(ctx context.Context, app *App, fromID, intoID int) error {
+	graph, err := Build(ctx, app)
+	if err != nil {
+		return err
+	}
+
+	from := graph.Tag(fromID)
+	if from == nil {
+		return fmt.Errorf("%w: %d", ErrUnknownTag, fromID)
+	} else if graph.Tag(intoID) == nil {
+		return fmt.Errorf("%w: %d", ErrUnknownTag, intoID)
+	} else if fromID == intoID {
+		return nil
+	}
+
+	kinds := make([]string, 0, len(from.Used))
+	for kind := range from.Used {
+		kinds = append(kinds, kind)
+	}
+
+	slices.Sort(kinds)
+
+	for _, kind := range kinds {
+		for _, id := range from.Used[kind] {
+			if err := retag(ctx, app, kind, id, fromID, intoID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if graph, err = Build(ctx, app); err != nil {
+		return err
+	}
+
+	if tag := graph.Tag(fromID); tag != nil && !tag.Orphan() {
+		return fmt.Errorf("%w: %s (%d) has %d resources", ErrTagInUse, tag.Label, tag.ID, tag.Count())
+	}
+
+	return deleteTag(ctx, app, fromID)
+}
+
+// DeleteOrphans deletes every tag nothing uses, and returns the deleted tags.
+func DeleteOrphans(ctx context.Context, app *App) ([]*Tag, error) {
+	graph, err := Build(ctx, app)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted []*Tag
+
+	for _, tag := range graph.Orphans() {
+		if err := deleteTag(ctx, app, tag.ID); err != nil {
+			return deleted, err
+		}
+
+		deleted = append(deleted, tag)
+	}
+
+	return deleted, nil
+}
+
+func deleteTag(ctx context.Context, app *App, tagID int) error {
+	req := starr.Request{URI: path.Join(app.version, "tag", starr.Str(tagID))}
+	if err := app.api.DeleteAny(ctx, req); err != nil {
+		return fmt.Errorf("api.Delete(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// retag replaces one tag with another on a single resource. The resource is edited
+// as raw json, so fields this library does not know about are not lost.
+func retag(ctx context.Context, app *App, kind string, id, fromID, intoID int) error {
+	var (
+		item map[string]json.RawMessage
+		tags []int
+	)
+
+	req := starr.Request{URI: path.Join(app.version, kind, starr.Str(id))}
+	if err := app.api.GetInto(ctx, req, &item); err != nil {
+		return fmt.Errorf("api.Get(%s): %w", &req, err)
+	}
+
+	if raw, ok := item["tags"]; ok {
+		if err := json.Unmarshal(raw, &tags); err != nil {
+			return fmt.Errorf("json.Unmarshal(%s tags): %w", &req, err)
+		}
+	}
+
+	tags = slices.DeleteFunc(tags, func(tag int) bool { return tag == fromID })
+	if !slices.Contains(tags, intoID) {
+		tags = append(tags, intoID)
+	}
+
+	var err error
+	if item["tags"], err = json.Marshal(tags); err != nil {
+		return fmt.Errorf("json.Marshal(%s tags): %w", &req, err)
+	}
+
+	var body bytes.Buffer
+	if err := json.NewEncoder(&body).Encode(item); err != nil {
+		return fmt.Errorf("json.Marshal(%s): %w", &req, err)
+	}
+
+	req.Body = &body
+	if provider(kind) {
+		req.Query = starr.ForceSave(true) // Do not fail on provider test warnings.
+	}
+
+	var output map[string]json.RawMessage
+	if err := app.api.PutInto(ctx, req, &output); err != nil {
+		return fmt.Errorf("api.Put(%s): %w", &req, err)
+	}
+
+	return nil
+}
+
+// provider returns true for the kinds that are providers, and accept the forceSave parameter.
+func provider(kind string) bool {
+	switch kind {
+	case KindIndexer, KindIndexerProxy, KindDownloadClient, KindNotification, KindImportList, KindApplication:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,112 @@
+package starrtags_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golift.io/starr"
+	"golift.io/starr/sonarr"
+	"golift.io/starr/starrtags"
+	"golift.io/starr/starrtest"
+)
+
+const tagDetails = `[
+	{"id":1,"label":"hd","seriesIds":[10],"indexerIds":[20]},
+	{"id":2,"label":"uhd","seriesIds":[11]},
+	{"id":3,"label":"old"}]`
+
+func sonarrServer(t *testing.T, routes map[string][]string) (*starrtags.App, *starrtest.MockServer) {
+	t.Helper()
+
+	server := starrtest.NewMockServer(t, routes)
+
+	return starrtags.Sonarr(sonarr.New(starr.New("apikey", server.URL, 0))), server
+}
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	app, _ := sonarrServer(t, map[string][]string{"GET /api/v3/tag/detail": {tagDetails}})
+
+	graph, err := starrtags.Build(t.Context(), app)
+	require.NoError(t, err)
+	require.Len(t, graph.Tags, 3)
+	assert.Equal(t, map[string][]int{starrtags.KindSeries: {10}, starrtags.KindIndexer: {20}}, graph.Tag(1).Used)
+	assert.Equal(t, 2, graph.Tag(1).Count())
+	assert.Equal(t, 2, graph.Label("UHD").ID, "labels are not case sensitive")
+	assert.Nil(t, graph.Tag(4))
+	require.Len(t, graph.Orphans(), 1)
+	assert.Equal(t, "old", graph.Orphans()[0].Label)
+}
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	app, fake := sonarrServer(t, map[string][]string{
+		"GET /api/v3/tag/detail": {tagDetails, `[{"id":1,"label":"hd"},{"id":2,"label":"uhd","seriesIds":[10,11]}]`},
+		"GET /api/v3/series/10":  {`{"id":10,"title":"Show","tags":[1,2],"unknownField":{"keep":true}}`},
+		"PUT /api/v3/series/10":  {`{"id":10}`},
+		"GET /api/v3/indexer/20": {`{"id":20,"name":"Indexer","tags":[1]}`},
+		"PUT /api/v3/indexer/20": {`{"id":20}`},
+		"DELETE /api/v3/tag/1":   {``},
+	})
+
+	require.NoError(t, starrtags.Merge(t.Context(), app, 1, 2))
+	assert.JSONEq(t, `{"id":10,"title":"Show","tags":[2],"unknownField":{"keep":true}}`,
+		fake.Last("PUT /api/v3/series/10").Body, "only the tags may change")
+	assert.JSONEq(t, `{"id":20,"name":"Indexer","tags":[2]}`, fake.Last("PUT /api/v3/indexer/20").Body)
+	assert.Equal(t, "true", fake.Last("PUT /api/v3/indexer/20").Query.Get("forceSave"), "providers must be force saved")
+	assert.Empty(t, fake.Last("PUT /api/v3/series/10").Query.Get("forceSave"))
+	assert.Equal(t, 1, fake.Count("DELETE /api/v3/tag/1"))
+}
+
+func TestMergeErrors(t *testing.T) {
+	t.Parallel()
+
+	app, fake := sonarrServer(t, map[string][]string{
+		"GET /api/v3/tag/detail": {tagDetails},
+		"GET /api/v3/series/11":  {`{"id":11,"tags":[2]}`},
+		"PUT /api/v3/series/11":  {`{"id":11}`},
+	})
+
+	require.ErrorIs(t, starrtags.Merge(t.Context(), app, 9, 1), starrtags.ErrUnknownTag)
+	require.ErrorIs(t, starrtags.Merge(t.Context(), app, 2, 9), starrtags.ErrUnknownTag)
+	require.ErrorIs(t, starrtags.Merge(t.Context(), app, 2, 3), starrtags.ErrTagInUse)
+	assert.Zero(t, fake.Count("DELETE /api/v3/tag/2"), "a tag in use must not be deleted")
+}
+
+func TestRename(t *testing.T) {
+	t.Parallel()
+
+	app, fake := sonarrServer(t, map[string][]string{
+		"GET /api/v3/tag/detail": {tagDetails},
+		"PUT /api/v3/tag/3":      {`{"id":3,"label":"new"}`},
+	})
+
+	tag, err := starrtags.Rename(t.Context(), app, 3, "new")
+	require.NoError(t, err)
+	assert.Equal(t, &starr.Tag{ID: 3, Label: "new"}, tag)
+	assert.JSONEq(t, `{"id":3,"label":"new"}`, fake.Last("PUT /api/v3/tag/3").Body)
+
+	_, err = starrtags.Rename(t.Context(), app, 3, "HD")
+	require.ErrorIs(t, err, starrtags.ErrLabelExists)
+	_, err = starrtags.Rename(t.Context(), app, 9, "nine")
+	require.ErrorIs(t, err, starrtags.ErrUnknownTag)
+}
+
+func TestDeleteOrphans(t *testing.T) {
+	t.Parallel()
+
+	app, fake := sonarrServer(t, map[string][]string{
+		"GET /api/v3/tag/detail": {tagDetails},
+		"DELETE /api/v3/tag/3":   {``},
+	})
+
+	deleted, err := starrtags.DeleteOrphans(t.Context(), app)
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	assert.Equal(t, 3, deleted[0].ID)
+	assert.Equal(t, 1, fake.Count("DELETE /api/v3/tag/3"))
+	assert.Zero(t, fake.Count("DELETE /api/v3/tag/1"))
+}
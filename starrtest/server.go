@@ -0,0 +1,104 @@
+package starrtest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// MockServer is a mock Starr app for tests that make more than one request.
+// Routes are a method and a path, like "GET /api/v3/tag". A route with more than one response
+// returns the next one on each call, and repeats the last one. Requests without a route get
+// a 404 Not Found. Every request is recorded, with or without a route.
+type MockServer struct {
+	*httptest.Server
+
+	routes map[string][]string
+	mu     sync.Mutex
+	calls  []*MockCall
+	count  map[string]int
+}
+
+// MockCall is one request received by a MockServer.
+type MockCall struct {
+	// Route is the request method and path, like "PUT /api/v3/tag/1".
+	Route string
+	Query url.Values
+	Body  string
+}
+
+// NewMockServer starts a MockServer with these routes. It is closed when the test ends.
+func NewMockServer(t *testing.T, routes map[string][]string) *MockServer {
+	t.Helper()
+
+	mock := &MockServer{routes: routes, count: make(map[string]int)}
+	mock.Server = httptest.NewServer(http.HandlerFunc(mock.serveHTTP))
+	t.Cleanup(mock.Close)
+
+	return mock
+}
+
+func (m *MockServer) serveHTTP(writer http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	call := &MockCall{Route: req.Method + " " + req.URL.Path, Query: req.URL.Query(), Body: string(body)}
+
+	m.mu.Lock()
+	m.calls = append(m.calls, call)
+	m.count[call.Route]++
+	count := m.count[call.Route]
+	m.mu.Unlock()
+
+	responses, ok := m.routes[call.Route]
+	if !ok || len(responses) == 0 {
+		writer.WriteHeader(http.StatusNotFound)
+		_, _ = writer.Write([]byte(BodyNotFound))
+
+		return
+	}
+
+	_, _ = writer.Write([]byte(responses[min(count, len(responses))-1]))
+}
+
+// Count returns the number of requests to a route.
+func (m *MockServer) Count(route string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.count[route]
+}
+
+// Last returns the last request to a route. It is empty if the route was not called.
+func (m *MockServer) Last(route string) *MockCall {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for idx := len(m.calls) - 1; idx >= 0; idx-- {
+		if m.calls[idx].Route == route {
+			return m.calls[idx]
+		}
+	}
+
+	return &MockCall{Route: route}
+}
+
+// Calls returns the route of every request, in order. Pass methods to only return those requests.
+func (m *MockServer) Calls(methods ...string) []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	routes := []string{}
+
+	for _, call := range m.calls {
+		method, _, _ := strings.Cut(call.Route, " ")
+		if len(methods) == 0 || slices.Contains(methods, method) {
+			routes = append(routes, call.Route)
+		}
+	}
+
+	return routes
+}
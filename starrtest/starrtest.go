@@ -2,12 +2,14 @@
 package starrtest
 
 import (
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // MockData allows generic testing of http inputs and outputs.
@@ -62,3 +64,30 @@ func (test *MockData) GetMockServer(t *testing.T) *httptest.Server {
 		assert.NoError(t, err)
 	}))
 }
+
+// AssertToInput checks that an output's ToInput method copied every value the input type holds.
+// The expected input is made by decoding the output's json into the input type; the Input and
+// Output types share json tags, so that round trip keeps exactly what the input should keep.
+func AssertToInput[I any](t *testing.T, output any, input *I) {
+	t.Helper()
+
+	data, err := json.Marshal(output)
+	require.NoError(t, err)
+
+	expected := new(I)
+	require.NoError(t, json.Unmarshal(data, expected))
+	assert.Equal(t, expected, input, "ToInput did not copy every value from the output")
+}
+
+// ToInputCase returns a table test for an output type's ToInput method. It decodes the json body into
+// the output type, checks the conversion with AssertToInput, and checks that a nil output returns nil.
+func ToInputCase[O, I any](toInput func(*O) *I, body string) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Helper()
+
+		output := new(O)
+		require.NoError(t, json.Unmarshal([]byte(body), output))
+		AssertToInput(t, output, toInput(output))
+		assert.Nil(t, toInput(nil), "a nil output must convert to a nil input")
+	}
+}